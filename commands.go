@@ -5,21 +5,55 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
-
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/achievements"
+	"github.com/Nachsus/pokedexcli/internal/capture"
+	"github.com/Nachsus/pokedexcli/internal/experience"
+	"github.com/Nachsus/pokedexcli/internal/inventory"
 	"github.com/Nachsus/pokedexcli/internal/pokeapi"
 	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/quests"
+	"github.com/Nachsus/pokedexcli/internal/render"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+	"github.com/Nachsus/pokedexcli/internal/wallet"
 )
 
 type cliCommand struct {
 	name        string
 	description string
 	callback    func(args []string) error
+	// keepCase passes arguments as typed instead of lowercased, for
+	// commands that take file paths.
+	keepCase bool
 }
 
 var supportedCommands map[string]cliCommand
 var userPokedex = pokedex.NewPokedex()
+var userStorage = pokedex.NewStorage()
+var userSeen = pokedex.NewSeen()
+var userJournal = pokedex.NewJournal(journalSize)
+var userAchievements = achievements.NewTracker()
+var userQuests = quests.NewBoard()
+var userInventory = inventory.NewInventory()
+var userWallet = wallet.NewWallet(0)
+var catchMode = capture.ModeAuthentic
+var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+var lastArea string
+var lastAreas []string
+var lastEncounters = make(map[string]pokeapi.Encounter)
+
+const (
+	minWildLevel = 2
+	maxWildLevel = 10
+)
 
 func init() {
+	watchPokedex(userPokedex)
 	supportedCommands = map[string]cliCommand{
 		"help": {
 			name:        "help",
@@ -48,19 +82,176 @@ func init() {
 		},
 		"catch": {
 			name:        "catch",
-			description: "Attempts to catch a Pokemon based on its base experience",
+			description: "Throws a ball at a Pokemon: catch <pokemon> [ball] [nickname]",
 			callback:    commandCatch,
 		},
+		"catchmode": {
+			name:        "catchmode",
+			description: "Shows or sets the catch formula: catchmode [authentic|legacy]",
+			callback:    commandCatchMode,
+		},
 		"inspect": {
 			name:        "inspect",
-			description: "Inspects the data for a Pokemon you have in your Pokedex",
+			description: "Inspects a caught Pokemon: inspect <id|nickname|name> [--level n]",
 			callback:    commandInspect,
 		},
 		"pokedex": {
 			name:        "pokedex",
-			description: "Lists names of all caught Pokemon",
+			description: "Lists caught Pokemon, optionally filtered: pokedex [--seen] [type:fire] [tag:starter] [speed>90] [sort:-attack] [limit:10]",
 			callback:    commandPokedex,
 		},
+		"bag": {
+			name:        "bag",
+			description: "Lists your bag, or uses an item in battle: bag [item] [pokemon]",
+			callback:    commandBag,
+		},
+		"use": {
+			name:        "use",
+			description: "Uses an item: use <item> [on <pokemon>]",
+			callback:    commandUse,
+		},
+		"toss": {
+			name:        "toss",
+			description: "Throws away items: toss <item> [quantity]",
+			callback:    commandToss,
+		},
+		"shop": {
+			name:        "shop",
+			description: "Lists items for sale and their prices",
+			callback:    commandShop,
+		},
+		"buy": {
+			name:        "buy",
+			description: "Buys items from the shop: buy <item> [quantity]",
+			callback:    commandBuy,
+		},
+		"sell": {
+			name:        "sell",
+			description: "Sells items for half their price: sell <item> [quantity]",
+			callback:    commandSell,
+		},
+		"battle": {
+			name:        "battle",
+			description: "Starts a battle with a wild Pokemon: battle <pokemon>",
+			callback:    commandBattle,
+		},
+		"fight": {
+			name:        "fight",
+			description: "Lists your moves or uses one in battle: fight [move]",
+			callback:    commandFight,
+		},
+		"switch": {
+			name:        "switch",
+			description: "Switches your active Pokemon in battle: switch <pokemon|slot>",
+			callback:    commandSwitch,
+		},
+		"run": {
+			name:        "run",
+			description: "Tries to run away from a battle",
+			callback:    commandRun,
+		},
+		"stats": {
+			name:        "stats",
+			description: "Shows how complete your collection is against the national and regional dexes",
+			callback:    commandStats,
+		},
+		"export": {
+			name:        "export",
+			description: "Exports your pokedex: export <file> [--format json|csv]",
+			callback:    commandExport,
+			keepCase:    true,
+		},
+		"import": {
+			name:        "import",
+			description: "Imports a pokedex export: import <file> [--merge|--replace]",
+			callback:    commandImport,
+			keepCase:    true,
+		},
+		"save": {
+			name:        "save",
+			description: "Saves the game, or checks the save file for damage: save [verify]",
+			callback:    commandSave,
+		},
+		"data": {
+			name:        "data",
+			description: "Shows the offline snapshot, or downloads PokeAPI data into it: data [sync [resource...]]",
+			callback:    commandData,
+		},
+		"profile": {
+			name:        "profile",
+			description: "Manages trainer profiles: profile [new|list|switch|delete|rename]",
+			callback:    commandProfile,
+		},
+		"party": {
+			name:        "party",
+			description: "Lists the Pokemon in your party",
+			callback:    commandParty,
+		},
+		"box": {
+			name:        "box",
+			description: "Lists your PC boxes, or the Pokemon in one: box [n]",
+			callback:    commandBox,
+		},
+		"deposit": {
+			name:        "deposit",
+			description: "Sends a party Pokemon to the PC: deposit <pokemon>",
+			callback:    commandDeposit,
+		},
+		"withdraw": {
+			name:        "withdraw",
+			description: "Brings a Pokemon from the PC into your party: withdraw <pokemon>",
+			callback:    commandWithdraw,
+		},
+		"move": {
+			name:        "move",
+			description: "Moves a Pokemon to a box, or to the party with box 0: move <id> <box>",
+			callback:    commandMove,
+		},
+		"swap": {
+			name:        "swap",
+			description: "Swaps the places of two Pokemon: swap <pokemon> <pokemon>",
+			callback:    commandSwap,
+		},
+		"release": {
+			name:        "release",
+			description: "Releases a caught Pokemon after asking first: release <pokemon> [--yes]",
+			callback:    commandRelease,
+		},
+		"nickname": {
+			name:        "nickname",
+			description: "Gives a Pokemon a nickname, or clears it: nickname <pokemon> [name]",
+			callback:    commandNickname,
+		},
+		"favorite": {
+			name:        "favorite",
+			description: "Adds a Pokemon to your favorites, or removes it again: favorite <pokemon>",
+			callback:    commandFavorite,
+		},
+		"tag": {
+			name:        "tag",
+			description: "Lists your tags, or adds and removes a Pokemon's: tag [<pokemon> [tag] [-tag]]",
+			callback:    commandTag,
+		},
+		"achievements": {
+			name:        "achievements",
+			description: "Shows your achievements and how close you are to each",
+			callback:    commandAchievements,
+		},
+		"quests": {
+			name:        "quests",
+			description: "Shows today's quests, which raise your catch chance when completed",
+			callback:    commandQuests,
+		},
+		"undo": {
+			name:        "undo",
+			description: "Undoes the last catch, release, nickname or tag change",
+			callback:    commandUndo,
+		},
+		"redo": {
+			name:        "redo",
+			description: "Redoes the last undone change",
+			callback:    commandRedo,
+		},
 	}
 }
 
@@ -86,10 +277,7 @@ func commandMap(args []string) error {
 		return err
 	}
 
-	for _, name := range areaNames {
-		fmt.Println(name)
-	}
-
+	showAreas(areaNames)
 	return nil
 }
 
@@ -99,13 +287,15 @@ func commandMapB(args []string) error {
 		return err
 	}
 
-	for _, name := range areaNames {
-		fmt.Println(name)
-	}
-
+	showAreas(areaNames)
 	return nil
 }
 
+func showAreas(areaNames []string) {
+	lastAreas = areaNames
+	fmt.Print(render.Grid(style(), areaNames))
+}
+
 func commandExplore(args []string) error {
 	if len(args) == 0 {
 		return errors.New("please provide a location area name")
@@ -114,107 +304,471 @@ func commandExplore(args []string) error {
 	areaName := args[0]
 	fmt.Printf("Exploring %s...\n", areaName)
 
-	pokemonNames, err := pokeapi.GetPokemonFromArea(areaName, &pokeapi.Conf)
+	encounters, err := pokeapi.GetAreaEncounters(areaName, &pokeapi.Conf)
 	if err != nil {
 		return err
 	}
 
+	lastArea = areaName
+	lastEncounters = make(map[string]pokeapi.Encounter, len(encounters))
+
 	fmt.Println("Found Pokemon:")
-	for _, name := range pokemonNames {
-		fmt.Println(" - " + name)
+	now := time.Now()
+	table := render.Table{Headers: []string{"Pokemon", "Levels", "Caught"}, Right: []int{1, 2}}
+	for _, encounter := range encounters {
+		lastEncounters[encounter.Name] = encounter
+		userSeen.Mark(encounter.Name, areaName, now)
+
+		levels := fmt.Sprintf("%d-%d", encounter.MinLevel, encounter.MaxLevel)
+		if encounter.MinLevel == encounter.MaxLevel {
+			levels = strconv.Itoa(encounter.MinLevel)
+		}
+		table.Add(encounter.Name, levels, strconv.Itoa(userPokedex.Count(encounter.Name)))
 	}
+	fmt.Print(table.Render(style()))
 
+	explored := userAchievements.Progress().Areas[areaName]
+	track(achievements.Explore{Area: areaName})
+	trackQuest(quests.Explore{Area: areaName, New: !explored})
 	return nil
 }
 
+type wildPokemon struct {
+	entry   pokedex.Entry
+	species *pokeapi.PokemonSpecies
+}
+
+// meetWild fetches a Pokemon and rolls the individual it would be if caught,
+// using the level range of the last explored area when it lives there.
+func meetWild(pokemonName string) (*wildPokemon, error) {
+	pokemon, err := pokeapi.GetPokemon(pokemonName, &pokeapi.Conf)
+	if err != nil {
+		return nil, err
+	}
+
+	species, err := pokeapi.GetPokemonSpecies(pokemon.SpeciesName(), &pokeapi.Conf)
+	if err != nil {
+		return nil, err
+	}
+
+	level := minWildLevel + rng.Intn(maxWildLevel-minWildLevel+1)
+	area := ""
+	if encounter, ok := lastEncounters[pokemon.Name]; ok {
+		area = lastArea
+		if encounter.MaxLevel > 0 {
+			level = encounter.MinLevel + rng.Intn(encounter.MaxLevel-encounter.MinLevel+1)
+		}
+	}
+
+	curve, err := growthCurve(species.GrowthRate)
+	if err != nil {
+		return nil, err
+	}
+
+	userSeen.Mark(pokemon.Name, area, time.Now())
+	trackQuest(quests.Find{Weight: pokemon.Weight})
+
+	entry := pokedex.NewEntry(*pokemon, species.GenderRate, level, rng)
+	entry.GrowthRate = species.GrowthRate
+	entry.Experience = curve.ForLevel(level)
+	entry.Area = area
+	entry.Region = species.Region
+	return &wildPokemon{entry: entry, species: species}, nil
+}
+
 func commandCatch(args []string) error {
 	if len(args) == 0 {
 		return errors.New("please provide a pokemon name")
 	}
 
 	pokemonName := args[0]
+	ballName := "poke-ball"
+	if len(args) > 1 {
+		ballName = args[1]
+	}
+	nickname := ""
+	if len(args) > 2 {
+		nickname = args[2]
+	}
 
-	if userPokedex.Has(pokemonName) {
-		fmt.Printf("You already caught %s!\n", pokemonName)
-		return nil
+	// Check before a ball is spent, since Rename and undo hold nicknames to
+	// the same rule
+	if err := pokedex.ValidateNickname(nickname); err != nil {
+		return err
 	}
 
-	fmt.Printf("Throwing a Pokeball at %s...\n", pokemonName)
+	ball, ok := capture.GetBall(ballName)
+	if !ok {
+		return errors.New("unknown ball " + ballName)
+	}
 
-	pokemon, err := pokeapi.GetPokemon(pokemonName, &pokeapi.Conf)
+	if !userInventory.Has(ball.Name) {
+		return errors.New("you don't have any " + ball.Name + " left")
+	}
+
+	if userStorage.Full() {
+		return errors.New("your party and PC boxes are full")
+	}
+
+	wild, err := meetWild(pokemonName)
 	if err != nil {
 		return err
 	}
 
-	const minChance = 30.0
-	const maxChance = 80.0
-	const maxBaseXP = 608.0
+	if err := userInventory.Remove(ball.Name, 1); err != nil {
+		return err
+	}
 
-	catchChance := maxChance - ((float64(pokemon.BaseExperience) / maxBaseXP) * (maxChance - minChance))
-	if catchChance < minChance {
-		catchChance = minChance
+	target := capture.Target{Level: wild.entry.Level}
+	result, err := throwBall(wild, ball, target, 1)
+	if err != nil {
+		return err
 	}
-	if catchChance > maxChance {
-		catchChance = maxChance
+
+	if result.Caught {
+		return recordCatch(wild, ball, nickname)
 	}
 
-	roll := rand.Float64() * 100.0
-	if roll <= catchChance {
-		userPokedex.Add(*pokemon)
-		fmt.Printf("%s was caught!\n", pokemonName)
-		fmt.Println("You may now inspect it with the inspect command.")
-	} else {
-		fmt.Printf("%s escaped!\n", pokemonName)
+	fmt.Printf("%s escaped!\n", pokemonName)
+	track(achievements.Escape{Species: pokemonName})
+	return nil
+}
+
+// throwBall runs the active catch formula against target, filling in the
+// species data it needs, and prints the shake checks.
+func throwBall(wild *wildPokemon, ball capture.Ball, target capture.Target, turn int) (capture.Result, error) {
+	pokemon := wild.entry.Pokemon
+	bonus := userQuests.CatchBonus(time.Now())
+	if catchMode == capture.ModeLegacy {
+		fmt.Printf("Throwing a Pokeball at %s...\n", pokemon.Name)
+		return capture.ThrowLegacy(pokemon.BaseExperience, bonus, rng), nil
+	}
+
+	fmt.Printf("Throwing a %s at %s...\n", ball.Name, pokemon.Name)
+	target.CaptureRate = wild.species.CaptureRate
+	target.Types = pokemon.Types
+	ctx := capture.Context{
+		Turn:          turn,
+		Night:         isNight(time.Now()),
+		AlreadyCaught: userPokedex.Has(pokemon.Name),
+		Bonus:         bonus,
+	}
+	result := capture.Throw(target, ball, ctx, rng)
+	printShakes(result)
+	return result, nil
+}
+
+func recordCatch(wild *wildPokemon, ball capture.Ball, nickname string) error {
+	entry := wild.entry
+	entry.Ball = ball.Name
+	entry.Nickname = nickname
+	entry.CaughtAt = time.Now()
+
+	// Announced first, since watchPokedex reports what the catch unlocked
+	fmt.Printf("%s was caught!\n", entry.Pokemon.Name)
+	showSprite(entry)
+	if entry.Shiny {
+		fmt.Println("It's shiny!")
 	}
 
+	entry = userPokedex.Add(entry)
+	userJournal.Record(pokedex.Mutation{Op: pokedex.OpAdd, Entry: entry})
+
+	location, err := userStorage.Place(entry.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Registered as #%d (Lv. %d).\n", entry.ID, entry.Level)
+	fmt.Printf("%s was sent to %s.\n", entry.DisplayName(), location)
+
+	awardCatchExperience(entry)
+
+	reward := catchReward(entry.Pokemon.BaseExperience)
+	if err := userWallet.Deposit(reward); err != nil {
+		return err
+	}
+	fmt.Printf("You earned $%d.\n", reward)
+	fmt.Printf("You may now inspect it with: inspect %d\n", entry.ID)
 	return nil
 }
 
-func commandInspect(args []string) error {
+// awardCatchExperience shares the experience for catching caught with every
+// other member of the party. Experience is a bonus on top of the catch, so a
+// member whose growth rate can't be fetched just misses out.
+func awardCatchExperience(caught pokedex.Entry) {
+	xp := experience.CatchYield(caught.Pokemon.BaseExperience, caught.Level)
+
+	for _, entry := range partyEntries() {
+		if entry.ID == caught.ID || entry.Level >= experience.MaxLevel {
+			continue
+		}
+		if err := gainExperience(entry, xp); err != nil {
+			fmt.Printf("%s couldn't gain experience: %s\n", entry.DisplayName(), err)
+		}
+	}
+}
+
+func gainExperience(entry pokedex.Entry, xp int) error {
+	if entry.GrowthRate == "" {
+		species, err := pokeapi.GetPokemonSpecies(entry.Pokemon.SpeciesName(), &pokeapi.Conf)
+		if err != nil {
+			return err
+		}
+		entry.GrowthRate = species.GrowthRate
+	}
+	curve, err := growthCurve(entry.GrowthRate)
+	if err != nil {
+		return err
+	}
+
+	// Level ups are announced by watchPokedex once the update lands
+	entry.GainExperience(xp, curve)
+	fmt.Printf("%s gained %d Exp. Points!\n", entry.DisplayName(), xp)
+	return userPokedex.Update(entry)
+}
+
+func printLevelUp(entry pokedex.Entry, before map[string]int) {
+	fmt.Printf("%s grew to Lv. %d!\n", entry.DisplayName(), entry.Level)
+	after := entry.Stats()
+	for _, name := range stats.Names {
+		fmt.Printf("  -%s: %d (+%d)\n", name, after[name], after[name]-before[name])
+	}
+}
+
+func growthCurve(name string) (experience.Curve, error) {
+	rate, err := pokeapi.GetGrowthRate(name, &pokeapi.Conf)
+	if err != nil {
+		return nil, err
+	}
+	return experience.Curve(rate.Experience), nil
+}
+
+func printShakes(result capture.Result) {
+	for i := 1; i <= result.Shakes; i++ {
+		fmt.Printf("%d... ", i)
+	}
+	if result.Caught {
+		fmt.Println("Gotcha!")
+	} else {
+		fmt.Println("Oh no!")
+	}
+}
+
+func isNight(t time.Time) bool {
+	return t.Hour() >= 20 || t.Hour() < 6
+}
+
+func commandCatchMode(args []string) error {
 	if len(args) == 0 {
-		return errors.New("please provide a pokemon name")
+		fmt.Printf("Current catch mode: %s\n", catchMode)
+		return nil
 	}
 
-	pokemonName := args[0]
+	mode, err := capture.ParseMode(args[0])
+	if err != nil {
+		return err
+	}
+
+	catchMode = mode
+	fmt.Printf("Catch mode set to %s\n", catchMode)
+	return nil
+}
 
-	if !userPokedex.Has(pokemonName) {
-		return errors.New("You have not caught " + pokemonName)
+func commandInspect(args []string) error {
+	ref := ""
+	level := 0
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--level" && i+1 < len(args):
+			i++
+			arg = "--level=" + args[i]
+			fallthrough
+		case strings.HasPrefix(arg, "--level="):
+			n, err := strconv.Atoi(strings.TrimPrefix(arg, "--level="))
+			if err != nil || n < 1 || n > experience.MaxLevel {
+				return fmt.Errorf("level must be between 1 and %d", experience.MaxLevel)
+			}
+			level = n
+		case ref == "":
+			ref = arg
+		}
+	}
+	if ref == "" {
+		return errors.New("please provide a pokemon name or ID")
 	}
 
-	pokemon, ok := userPokedex.Get(pokemonName)
+	entry, ok := userPokedex.Find(ref)
 	if !ok {
-		return errors.New("error getting pokemon from pokedex")
+		sighting, seen := userSeen.Get(ref)
+		if !seen {
+			return errors.New("You have not caught " + ref)
+		}
+		fmt.Printf("Name: %s\n", sighting.Name)
+		fmt.Printf("Seen: %s\n", describeSighting(sighting))
+		fmt.Println("You haven't caught one yet.")
+		return nil
 	}
 
-	fmt.Printf("Name: %s\n", pokemon.Name)
+	pokemon := entry.Pokemon
+	out := style()
+
+	showSprite(entry)
+	fmt.Printf("Name: %s %s\n", out.Bold(pokemon.Name), out.Badges(pokemon.Types))
+	fmt.Printf("ID: %d\n", entry.ID)
+	if entry.Nickname != "" {
+		fmt.Printf("Nickname: %s\n", entry.Nickname)
+	}
+	if len(entry.Tags) > 0 {
+		fmt.Printf("Tags: %s\n", strings.Join(entry.Tags, ", "))
+	}
+	fmt.Printf("Level: %d\n", entry.Level)
+	fmt.Printf("Experience: %d\n", entry.Experience)
+
+	increased, decreased := natureEffect(entry.Nature)
+	if increased == "" {
+		fmt.Printf("Nature: %s (neutral)\n", entry.Nature)
+	} else {
+		fmt.Printf("Nature: %s (+%s, -%s)\n", entry.Nature, increased, decreased)
+	}
+
+	fmt.Printf("Gender: %s\n", entry.Gender)
+	if entry.Shiny {
+		fmt.Println("Shiny: yes")
+	}
 	fmt.Printf("Height: %d\n", pokemon.Height)
 	fmt.Printf("Weight: %d\n", pokemon.Weight)
-	fmt.Println("Stats:")
-	fmt.Printf("  -hp: %d\n", pokemon.Stats["hp"])
-	fmt.Printf("  -attack: %d\n", pokemon.Stats["attack"])
-	fmt.Printf("  -defense: %d\n", pokemon.Stats["defense"])
-	fmt.Printf("  -special-attack: %d\n", pokemon.Stats["special-attack"])
-	fmt.Printf("  -special-defense: %d\n", pokemon.Stats["special-defense"])
-	fmt.Printf("  -speed: %d\n", pokemon.Stats["speed"])
-	fmt.Println("Types:")
-	for _, typeName := range pokemon.Types {
-		fmt.Printf("  - %s\n", typeName)
+
+	actual := entry.Stats()
+	if level > 0 {
+		actual = entry.StatsAt(level)
+		fmt.Printf("Stats at Lv. %d (preview):\n", level)
+	} else {
+		fmt.Println("Stats:")
+	}
+	// The bar takes whatever room the numbers leave, within reason
+	barWidth := min(max(out.Width-50, 10), 30)
+	table := render.Table{Headers: []string{"", "base", "iv", "ev", "final", "", ""}, Right: []int{1, 2, 3, 4}}
+	for _, name := range stats.Names {
+		marker := ""
+		switch name {
+		case increased:
+			marker = "+"
+		case decreased:
+			marker = "-"
+		}
+		table.Add(name, strconv.Itoa(pokemon.Stats[name]), strconv.Itoa(entry.IVs[name]), strconv.Itoa(entry.EVs[name]),
+			strconv.Itoa(actual[name]), marker, out.Bar(pokemon.Stats[name], stats.MaxBase, barWidth))
+	}
+	fmt.Print(indent(table.Render(out), "  "))
+
+	area := entry.Area
+	if area == "" {
+		area = "an unknown area"
 	}
+	fmt.Printf("Caught: %s in %s with a %s\n", entry.CaughtAt.Format("2006-01-02"), area, entry.Ball)
 
 	return nil
 }
 
+// natureEffect looks a nature up on PokeAPI, falling back to the built-in
+// table the stat formulas use when it can't be reached.
+func natureEffect(name string) (increased, decreased string) {
+	if nature, err := pokeapi.GetNature(name, &pokeapi.Conf); err == nil {
+		if nature.IncreasedStat == nature.DecreasedStat {
+			return "", ""
+		}
+		return nature.IncreasedStat, nature.DecreasedStat
+	}
+	nature, _ := stats.GetNature(name)
+	return nature.Increased, nature.Decreased
+}
+
 func commandPokedex(args []string) error {
-	pokemon := userPokedex.GetAll()
-	if len(pokemon) < 1 {
+	if slices.Contains(args, "--seen") {
+		return listSeen()
+	}
+
+	query, err := pokedex.ParseQuery(args)
+	if err != nil {
+		return err
+	}
+
+	entries := userPokedex.GetAll()
+	if len(entries) < 1 {
 		fmt.Println("No pokemon in your pokedex")
 		return nil
 	}
 
+	entries = query.Apply(entries)
+	if len(entries) < 1 {
+		fmt.Println("No pokemon match your search")
+		return nil
+	}
+
+	out := style()
 	fmt.Println("Your Pokedex:")
-	for _, poke := range pokemon {
-		fmt.Println(" - " + poke.Name)
+	table := render.Table{Headers: []string{"#", "Name", "Lv", "Types", "Tags"}, Right: []int{0, 2}}
+	for _, entry := range entries {
+		table.Add(strconv.Itoa(entry.ID), entryName(entry), strconv.Itoa(entry.Level), out.Badges(entry.Pokemon.Types), strings.Join(entry.Tags, ","))
 	}
+	fmt.Print(table.Render(out))
 
 	return nil
 }
+
+func describeEntry(entry pokedex.Entry) string {
+	return fmt.Sprintf("#%d %s Lv.%d", entry.ID, entryName(entry), entry.Level)
+}
+
+// entryName is the nickname and species of a caught Pokemon, starred when
+// it is a favorite.
+func entryName(entry pokedex.Entry) string {
+	name := entry.Pokemon.Name
+	if entry.Nickname != "" {
+		name = fmt.Sprintf("%s (%s)", entry.Nickname, entry.Pokemon.Name)
+	}
+	if entry.HasTag(pokedex.FavoriteTag) {
+		name += " *"
+	}
+	return name
+}
+
+// indent puts prefix in front of every line of text.
+func indent(text, prefix string) string {
+	lines := strings.SplitAfter(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// listSeen lists every Pokemon met so far, with only where and when it was
+// first seen for those not caught yet.
+func listSeen() error {
+	sightings := userSeen.GetAll()
+	if len(sightings) < 1 {
+		fmt.Println("You haven't seen any pokemon yet")
+		return nil
+	}
+
+	fmt.Printf("Seen %d pokemon:\n", len(sightings))
+	for _, sighting := range sightings {
+		if n := userPokedex.Count(sighting.Name); n > 0 {
+			fmt.Printf(" - %s (caught x%d)\n", sighting.Name, n)
+		} else {
+			fmt.Printf(" - %s, seen %s\n", sighting.Name, describeSighting(sighting))
+		}
+	}
+	return nil
+}
+
+func describeSighting(sighting pokedex.Sighting) string {
+	area := sighting.Area
+	if area == "" {
+		area = "an unknown area"
+	}
+	return fmt.Sprintf("in %s on %s", area, sighting.FirstSeen.Format("2006-01-02"))
+}
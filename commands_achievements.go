@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/achievements"
+)
+
+func commandAchievements(args []string) error {
+	statuses := userAchievements.Statuses()
+
+	unlocked := 0
+	for _, status := range statuses {
+		if status.Unlocked() {
+			unlocked++
+		}
+	}
+	fmt.Printf("Achievements: %d/%d unlocked\n", unlocked, len(statuses))
+
+	for _, status := range statuses {
+		milestone := status.Milestone
+		fmt.Println()
+		if status.Unlocked() {
+			fmt.Printf("[x] %s - %s\n", milestone.Name, milestone.Description)
+			fmt.Printf("    Unlocked on %s\n", status.UnlockedAt.Format("2006-01-02"))
+			continue
+		}
+		fmt.Printf("[ ] %s - %s\n", milestone.Name, milestone.Description)
+		fmt.Printf("    %s\n", progress(status.Count, milestone.Goal))
+	}
+	return nil
+}
+
+// track counts an outcome toward achievements and announces anything it
+// unlocked.
+func track(outcome achievements.Outcome) {
+	for _, milestone := range userAchievements.Record(outcome, time.Now()) {
+		fmt.Printf("Achievement unlocked: %s! (%s)\n", milestone.Name, milestone.Description)
+	}
+}
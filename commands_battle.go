@@ -0,0 +1,245 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Nachsus/pokedexcli/internal/achievements"
+	"github.com/Nachsus/pokedexcli/internal/battle"
+	"github.com/Nachsus/pokedexcli/internal/capture"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+)
+
+type wildEncounter struct {
+	battle *battle.Battle
+	wild   *wildPokemon
+}
+
+var currentBattle *wildEncounter
+
+var battleCommands = []string{"fight", "switch", "bag", "run", "help", "exit", "inspect", "pokedex", "party"}
+
+func commandBattle(args []string) error {
+	if currentBattle != nil {
+		return errors.New("you are already in a battle")
+	}
+	if len(args) == 0 {
+		return errors.New("please provide a pokemon name")
+	}
+
+	members := partyEntries()
+	if len(members) == 0 {
+		return errors.New("you need a pokemon in your party before you can battle")
+	}
+
+	wild, err := meetWild(args[0])
+	if err != nil {
+		return err
+	}
+
+	wildCombatant, err := newCombatant(wild.entry)
+	if err != nil {
+		return err
+	}
+
+	party := make([]*battle.Combatant, 0, len(members))
+	for _, entry := range members {
+		c, err := newCombatant(entry)
+		if err != nil {
+			return err
+		}
+		party = append(party, c)
+	}
+
+	b, err := battle.New(party, wildCombatant, rng)
+	if err != nil {
+		return err
+	}
+	currentBattle = &wildEncounter{battle: b, wild: wild}
+
+	fmt.Printf("A wild %s (Lv. %d) appeared!\n", wild.entry.Pokemon.Name, wild.entry.Level)
+	fmt.Printf("Go! %s!\n", b.Active().Name)
+	printBattleStatus()
+	return nil
+}
+
+func newCombatant(entry pokedex.Entry) (*battle.Combatant, error) {
+	var moves []battle.Move
+	for _, name := range battle.MovesForLevel(entry.Pokemon.Moves, entry.Level) {
+		move, err := pokeapi.GetMove(name, &pokeapi.Conf)
+		if err != nil {
+			return nil, err
+		}
+		moves = append(moves, battle.NewMove(move))
+	}
+
+	return battle.NewCombatantWithStats(entry.DisplayName(), entry.Level, entry.Pokemon.Types, entry.Stats(), moves), nil
+}
+
+func requireBattle() (*battle.Battle, error) {
+	if currentBattle == nil {
+		return nil, errors.New("you are not in a battle")
+	}
+	return currentBattle.battle, nil
+}
+
+func commandFight(args []string) error {
+	b, err := requireBattle()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		fmt.Printf("%s's moves:\n", b.Active().Name)
+		hasPP := false
+		for _, slot := range b.Active().Moves {
+			fmt.Printf(" - %s (%s, %d/%d PP)\n", slot.Move.Name, slot.Move.Type, slot.PP, slot.Move.PP)
+			hasPP = hasPP || slot.PP > 0
+		}
+		if !hasPP {
+			fmt.Printf(" - %s\n", battle.Struggle.Name)
+		}
+		return nil
+	}
+
+	log, err := b.Fight(args[0])
+	if err != nil {
+		return err
+	}
+	printBattleLog(log)
+	return nil
+}
+
+func commandSwitch(args []string) error {
+	b, err := requireBattle()
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("please provide a pokemon name or party slot")
+	}
+
+	var log []string
+	if slot, convErr := strconv.Atoi(args[0]); convErr == nil {
+		log, err = b.SwitchSlot(slot - 1)
+	} else {
+		log, err = b.Switch(args[0])
+	}
+	if err != nil {
+		return err
+	}
+	printBattleLog(log)
+	return nil
+}
+
+func commandRun(args []string) error {
+	b, err := requireBattle()
+	if err != nil {
+		return err
+	}
+
+	log, err := b.Run()
+	if err != nil {
+		return err
+	}
+	printBattleLog(log)
+	return nil
+}
+
+func battleUseItem(args []string) error {
+	b := currentBattle.battle
+	itemName := args[0]
+	if !userInventory.Has(itemName) {
+		return errors.New("you don't have any " + itemName)
+	}
+
+	if ball, ok := capture.GetBall(itemName); ok && ball.Name == itemName {
+		return battleThrow(ball)
+	}
+
+	target := ""
+	if len(args) > 1 {
+		target = args[1]
+	}
+
+	log, err := b.UseItem(itemName, target)
+	if err != nil {
+		return err
+	}
+	if err := userInventory.Remove(itemName, 1); err != nil {
+		return err
+	}
+	printBattleLog(log)
+	return nil
+}
+
+func battleThrow(ball capture.Ball) error {
+	b := currentBattle.battle
+	wild := currentBattle.wild
+	if b.MustSwitch() {
+		return errors.New("choose your next pokemon with switch")
+	}
+
+	if userStorage.Full() {
+		return errors.New("your party and PC boxes are full")
+	}
+
+	if err := userInventory.Remove(ball.Name, 1); err != nil {
+		return err
+	}
+
+	result, err := throwBall(wild, ball, b.CatchTarget(0), b.Turn)
+	if err != nil {
+		return err
+	}
+
+	if result.Caught {
+		b.MarkCaught()
+		currentBattle = nil
+		return recordCatch(wild, ball, "")
+	}
+
+	track(achievements.Escape{Species: wild.entry.Pokemon.Name})
+	log := []string{fmt.Sprintf("wild %s broke free!", wild.entry.Pokemon.Name)}
+	printBattleLog(append(log, b.EnemyTurn()...))
+	return nil
+}
+
+func printBattleLog(log []string) {
+	for _, line := range log {
+		fmt.Println(line)
+	}
+
+	b := currentBattle.battle
+	if !b.Over() {
+		printBattleStatus()
+		return
+	}
+
+	switch b.Outcome() {
+	case battle.Won:
+		fmt.Printf("You defeated wild %s!\n", b.Wild.Name)
+	case battle.Lost:
+		fmt.Println("You blacked out!")
+	case battle.Fled:
+		fmt.Println("You ran away.")
+	}
+	currentBattle = nil
+}
+
+func printBattleStatus() {
+	b := currentBattle.battle
+	for _, c := range []*battle.Combatant{b.Active(), b.Wild} {
+		label := c.Name
+		if c == b.Wild {
+			label = "wild " + c.Name
+		}
+		status := ""
+		if c.Status != capture.StatusNone {
+			status = " [" + string(c.Status) + "]"
+		}
+		fmt.Printf("  %s Lv.%d HP %d/%d%s\n", label, c.Level, c.HP, c.MaxHP, status)
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/render"
+	"github.com/Nachsus/pokedexcli/internal/save"
+	"github.com/Nachsus/pokedexcli/internal/snapshot"
+)
+
+func commandData(args []string) error {
+	if len(args) == 0 || args[0] == "info" {
+		return dataInfo()
+	}
+	if args[0] != "sync" {
+		return errors.New("usage: data [info|sync [resource...]]")
+	}
+	if pokeapi.Offline() {
+		return errors.New("data sync needs the network, start the game without --offline")
+	}
+
+	kinds := pokeapi.DefaultSyncKinds
+	if len(args) > 1 {
+		kinds = args[1:]
+	}
+	for _, kind := range kinds {
+		if !slices.Contains(pokeapi.SyncKinds, kind) {
+			return fmt.Errorf("unknown resource %s, choose from %s", kind, strings.Join(pokeapi.SyncKinds, ", "))
+		}
+	}
+
+	path, err := snapshotPath()
+	if err != nil {
+		return err
+	}
+	snap, err := snapshot.Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		snap = snapshot.New(time.Now())
+	} else if err != nil {
+		return err
+	}
+
+	// Progress redraws one line, which only makes sense on a terminal
+	terminal := isTerminal(os.Stdout)
+	for _, kind := range kinds {
+		fmt.Printf("Downloading %s...", kind)
+		err := pokeapi.Sync(snap, kind, &pokeapi.Conf, func(done, total int) {
+			if terminal {
+				fmt.Printf("\rDownloading %s... %d/%d", kind, done, total)
+			}
+		})
+		fmt.Println()
+		if err != nil {
+			return err
+		}
+
+		// Keep each finished kind, so a failure later doesn't lose it
+		if err := snap.Write(path); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Saved %d resources to %s. Play without a network with --offline.\n", snap.Len(), path)
+	return nil
+}
+
+func dataInfo() error {
+	path, err := snapshotPath()
+	if err != nil {
+		return err
+	}
+	snap, err := snapshot.Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Println("There is no offline snapshot yet. Download one with: data sync")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	mode := "online"
+	if pokeapi.Offline() {
+		mode = "offline"
+	}
+	fmt.Printf("Snapshot: %s, first synced %s (playing %s)\n", path, snap.Created().Format("2006-01-02"), mode)
+
+	counts := snap.Counts()
+	table := render.Table{Headers: []string{"Resource", "Count", "Complete"}, Right: []int{1}}
+	for _, kind := range pokeapi.SyncKinds {
+		if counts[kind] == 0 {
+			continue
+		}
+		complete := "no"
+		if snap.Synced(kind) {
+			complete = "yes"
+		}
+		table.Add(kind, strconv.Itoa(counts[kind]), complete)
+	}
+	fmt.Print(table.Render(style()))
+	return nil
+}
+
+// goOffline makes every PokeAPI lookup read from the snapshot.
+func goOffline() error {
+	path, err := snapshotPath()
+	if err != nil {
+		return err
+	}
+	snap, err := snapshot.Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return errors.New("there is no offline snapshot yet, run data sync while online first")
+	}
+	if err != nil {
+		return err
+	}
+
+	pokeapi.UseSnapshot(snap)
+	fmt.Printf("Playing offline with %d resources first synced %s\n", snap.Len(), snap.Created().Format("2006-01-02"))
+	return nil
+}
+
+func snapshotPath() (string, error) {
+	dir, err := save.DefaultDir()
+	if err != nil {
+		return "", err
+	}
+	return snapshot.Path(dir), nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+var ballCategories = []string{"standard-balls", "special-balls", "apricorn-balls"}
+
+var targetedCategories = []string{"healing", "status-cures", "revival", "pp-recovery", "vitamins", "evolution"}
+
+func commandBag(args []string) error {
+	if currentBattle != nil && len(args) > 0 {
+		return battleUseItem(args)
+	}
+
+	fmt.Printf("Money: $%d\n", userWallet.Balance())
+
+	names := userInventory.Names()
+	if len(names) == 0 {
+		fmt.Println("Your bag is empty")
+		return nil
+	}
+
+	fmt.Println("Your Bag:")
+	for _, name := range names {
+		fmt.Printf(" - %s x%d\n", name, userInventory.Count(name))
+	}
+
+	return nil
+}
+
+func commandUse(args []string) error {
+	if len(args) == 0 {
+		return errors.New("please provide an item name")
+	}
+
+	itemName := args[0]
+	target := ""
+	switch {
+	case len(args) >= 3 && args[1] == "on":
+		target = args[2]
+	case len(args) == 2:
+		target = args[1]
+	}
+
+	if !userInventory.Has(itemName) {
+		return errors.New("you don't have any " + itemName)
+	}
+
+	item, err := pokeapi.GetItem(itemName, &pokeapi.Conf)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(ballCategories, item.Category) {
+		return errors.New("balls are thrown with: catch <pokemon> " + item.Name)
+	}
+	if target == "" && slices.Contains(targetedCategories, item.Category) {
+		return errors.New("please choose a pokemon: use " + item.Name + " on <pokemon>")
+	}
+	if target != "" {
+		entry, ok := userPokedex.Find(target)
+		if !ok {
+			return errors.New("You have not caught " + target)
+		}
+		target = entry.DisplayName()
+	}
+
+	if err := userInventory.Remove(item.Name, 1); err != nil {
+		return err
+	}
+
+	if target == "" {
+		fmt.Printf("You used a %s.\n", item.Name)
+	} else {
+		fmt.Printf("You used a %s on %s.\n", item.Name, target)
+	}
+	if item.Effect != "" {
+		fmt.Println(item.Effect)
+	}
+
+	return nil
+}
+
+func commandToss(args []string) error {
+	if len(args) == 0 {
+		return errors.New("please provide an item name")
+	}
+
+	itemName := args[0]
+	quantity, err := parseQuantity(args[1:])
+	if err != nil {
+		return err
+	}
+
+	if err := userInventory.Remove(itemName, quantity); err != nil {
+		return err
+	}
+
+	fmt.Printf("Threw away %d %s.\n", quantity, itemName)
+	return nil
+}
+
+// maxQuantity caps how many items change hands at once, which also keeps
+// price times quantity far from overflowing.
+const maxQuantity = 999
+
+func parseQuantity(args []string) (int, error) {
+	if len(args) == 0 {
+		return 1, nil
+	}
+
+	quantity, err := strconv.Atoi(args[0])
+	if err != nil || quantity <= 0 || quantity > maxQuantity {
+		return 0, fmt.Errorf("quantity must be between 1 and %d", maxQuantity)
+	}
+	return quantity, nil
+}
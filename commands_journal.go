@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+)
+
+const journalSize = 50
+
+func commandRelease(args []string) error {
+	skipConfirm := false
+	args = slices.DeleteFunc(slices.Clone(args), func(arg string) bool {
+		if arg == "--yes" || arg == "-y" {
+			skipConfirm = true
+			return true
+		}
+		return false
+	})
+
+	entry, err := findStored(args)
+	if err != nil {
+		return err
+	}
+
+	party := userStorage.Party()
+	if len(party) == 1 && party[0] == entry.ID && len(userPokedex.GetAll()) > 1 {
+		return errors.New("you can't leave your party empty")
+	}
+
+	question := fmt.Sprintf("Release %s? Once you leave this session it's gone for good.", describeEntry(entry))
+	if entry.HasTag(pokedex.FavoriteTag) {
+		question = fmt.Sprintf("%s is one of your favorites. Release it anyway?", describeEntry(entry))
+	}
+	if !skipConfirm && !confirm(question) {
+		fmt.Printf("%s stayed with you.\n", entry.DisplayName())
+		return nil
+	}
+
+	removed, err := userPokedex.Remove(entry.ID)
+	if err != nil {
+		return err
+	}
+	userStorage.Remove(entry.ID)
+	userJournal.Record(pokedex.Mutation{Op: pokedex.OpRemove, Entry: removed})
+
+	fmt.Printf("Bye-bye, %s!\n", removed.DisplayName())
+	return nil
+}
+
+func commandNickname(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: nickname <pokemon> [name]")
+	}
+
+	entry, err := findStored(args)
+	if err != nil {
+		return err
+	}
+
+	nickname := strings.Join(args[1:], " ")
+	if nickname == entry.Pokemon.Name {
+		nickname = ""
+	}
+	previous, err := userPokedex.Rename(entry.ID, nickname)
+	if err != nil {
+		return err
+	}
+	userJournal.Record(pokedex.Mutation{Op: pokedex.OpRename, Entry: entry, From: previous, To: nickname})
+
+	if nickname == "" {
+		fmt.Printf("%s no longer has a nickname.\n", entry.Pokemon.Name)
+		return nil
+	}
+	fmt.Printf("%s is now known as %s.\n", entry.Pokemon.Name, nickname)
+	return nil
+}
+
+func commandUndo(args []string) error {
+	mutation, err := userJournal.Undo(userPokedex)
+	if err != nil {
+		return err
+	}
+	if err := syncStorage(mutation.Entry.ID); err != nil {
+		return err
+	}
+	fmt.Printf("Undid: %s\n", mutation)
+	return nil
+}
+
+func commandRedo(args []string) error {
+	mutation, err := userJournal.Redo(userPokedex)
+	if err != nil {
+		return err
+	}
+	if err := syncStorage(mutation.Entry.ID); err != nil {
+		return err
+	}
+	fmt.Printf("Redid: %s\n", mutation)
+	return nil
+}
+
+// syncStorage gives a Pokemon that came back a slot, and frees the slot of
+// one that is gone.
+func syncStorage(id int) error {
+	_, owned := userPokedex.Get(id)
+	_, stored := userStorage.Locate(id)
+	switch {
+	case owned && !stored:
+		_, err := userStorage.Place(id)
+		return err
+	case !owned && stored:
+		userStorage.Remove(id)
+	}
+	return nil
+}
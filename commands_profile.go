@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func commandProfile(args []string) error {
+	if len(args) == 0 {
+		fmt.Printf("Current profile: %s\n", activeProfile)
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return listProfiles()
+	case "new":
+		if len(args) < 2 {
+			return errors.New("usage: profile new <name>")
+		}
+		if err := profiles.Create(args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("Created trainer profile %s. Use it with: profile switch %s\n", args[1], args[1])
+		return nil
+	case "switch":
+		if len(args) < 2 {
+			return errors.New("usage: profile switch <name>")
+		}
+		return switchProfile(args[1])
+	case "delete":
+		if len(args) < 2 {
+			return errors.New("usage: profile delete <name>")
+		}
+		if args[1] == activeProfile {
+			return errors.New("you can't delete the profile you're playing, switch to another one first")
+		}
+		if err := profiles.Delete(args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("Deleted trainer profile %s\n", args[1])
+		return nil
+	case "rename":
+		if len(args) < 3 {
+			return errors.New("usage: profile rename <old> <new>")
+		}
+		return renameProfile(args[1], args[2])
+	}
+	return errors.New("usage: profile [new|list|switch|delete|rename]")
+}
+
+func listProfiles() error {
+	names, err := profiles.List()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Trainer profiles:")
+	for _, name := range names {
+		marker := ""
+		if name == activeProfile {
+			marker = " (active)"
+		}
+		fmt.Printf(" - %s%s\n", name, marker)
+	}
+	return nil
+}
+
+func switchProfile(name string) error {
+	if name == activeProfile {
+		return errors.New("you are already playing as " + name)
+	}
+	if !profiles.Exists(name) {
+		return fmt.Errorf("profile %s doesn't exist, create it with: profile new %s", name, name)
+	}
+
+	if err := saveGame(); err != nil {
+		return err
+	}
+	previous := currentGame()
+	resetGame()
+	if err := loadGame(name); err != nil {
+		previous.restore()
+		return fmt.Errorf("couldn't load profile %s, still playing as %s: %w", name, activeProfile, err)
+	}
+
+	fmt.Printf("Switched to trainer profile %s\n", name)
+	return nil
+}
+
+func renameProfile(oldName, newName string) error {
+	if oldName == activeProfile {
+		// Write the latest state first so the renamed file is current
+		if err := saveGame(); err != nil {
+			return err
+		}
+	}
+	if err := profiles.Rename(oldName, newName); err != nil {
+		return err
+	}
+
+	if oldName == activeProfile {
+		activeProfile = newName
+		savePath = profiles.SavePath(newName)
+	}
+	fmt.Printf("Renamed trainer profile %s to %s\n", oldName, newName)
+	return nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/quests"
+)
+
+// questData draws the daily quests from PokeAPI.
+type questData struct{}
+
+func (questData) Types() (map[string][]string, error) {
+	return pokeapi.GetTypes(&pokeapi.Conf)
+}
+
+func (questData) Weight(pokemon string) (int, error) {
+	details, err := pokeapi.GetPokemon(pokemon, &pokeapi.Conf)
+	if err != nil {
+		return 0, err
+	}
+	return details.Weight, nil
+}
+
+// drawQuests puts today's quests on the board unless they're already there.
+// It's done when the game loads and by the quests command, never while
+// catching or exploring, since it can take a few PokeAPI lookups.
+func drawQuests(now time.Time) error {
+	if userQuests.Current(now) {
+		return nil
+	}
+	board, err := quests.Generate(now, questData{})
+	if err != nil {
+		return fmt.Errorf("couldn't draw today's quests: %w", err)
+	}
+	userQuests.Start(now, board)
+	return nil
+}
+
+func commandQuests(args []string) error {
+	now := time.Now()
+	if err := drawQuests(now); err != nil {
+		return err
+	}
+
+	fmt.Printf("Daily quests for %s:\n", now.Format("2006-01-02"))
+	for _, quest := range userQuests.Quests(now) {
+		mark := " "
+		if quest.Done() {
+			mark = "x"
+		}
+		fmt.Printf(" [%s] %s\n", mark, quest)
+		fmt.Printf("     %s\n", progress(quest.Count, quest.Goal))
+	}
+
+	if bonus := userQuests.CatchBonus(now); bonus > 0 {
+		fmt.Printf("Catch bonus for today: +%.0f%%\n", bonus*100)
+	} else {
+		fmt.Printf("Each completed quest raises your catch chance by %.0f%% for the rest of the day.\n", quests.BonusPerQuest*100)
+	}
+	return nil
+}
+
+// trackQuest counts an outcome toward today's quests and announces any it
+// completed.
+func trackQuest(outcome quests.Outcome) {
+	for _, quest := range userQuests.Record(outcome, time.Now()) {
+		fmt.Printf("Quest complete: %s! Your catch chance is up %.0f%% for today.\n", quest, quests.BonusPerQuest*100)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Nachsus/pokedexcli/internal/save"
+)
+
+func commandSave(args []string) error {
+	if len(args) == 0 {
+		if err := saveGame(); err != nil {
+			return err
+		}
+		fmt.Printf("Saved %s's game\n", activeProfile)
+		return nil
+	}
+
+	if args[0] != "verify" {
+		return errors.New("usage: save [verify]")
+	}
+
+	problems, err := save.Verify(savePath)
+	if err != nil {
+		return err
+	}
+	if len(problems) == 0 {
+		fmt.Printf("%s is intact (version %d, checksum ok)\n", savePath, save.CurrentVersion)
+		return nil
+	}
+
+	fmt.Printf("Found %d problem(s) in %s:\n", len(problems), savePath)
+	for _, problem := range problems {
+		fmt.Println(" - " + problem)
+	}
+	return nil
+}
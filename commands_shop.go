@@ -0,0 +1,117 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+var shopCatalog = []string{
+	"poke-ball",
+	"great-ball",
+	"ultra-ball",
+	"potion",
+	"super-potion",
+	"hyper-potion",
+	"antidote",
+	"paralyze-heal",
+	"awakening",
+	"burn-heal",
+	"ice-heal",
+	"full-heal",
+	"revive",
+}
+
+func catchReward(baseExperience int) int {
+	return 100 + baseExperience
+}
+
+func sellPrice(cost int) int {
+	return cost / 2
+}
+
+func commandShop(args []string) error {
+	fmt.Printf("Money: $%d\n", userWallet.Balance())
+	fmt.Println("For sale:")
+	for _, name := range shopCatalog {
+		item, err := pokeapi.GetItem(name, &pokeapi.Conf)
+		if err != nil {
+			return err
+		}
+		fmt.Printf(" - %s: $%d\n", item.Name, item.Cost)
+	}
+
+	return nil
+}
+
+func commandBuy(args []string) error {
+	if len(args) == 0 {
+		return errors.New("please provide an item name")
+	}
+
+	itemName := args[0]
+	if !slices.Contains(shopCatalog, itemName) {
+		return errors.New("the shop doesn't sell " + itemName)
+	}
+
+	quantity, err := parseQuantity(args[1:])
+	if err != nil {
+		return err
+	}
+
+	item, err := pokeapi.GetItem(itemName, &pokeapi.Conf)
+	if err != nil {
+		return err
+	}
+
+	total := item.Cost * quantity
+	if err := userWallet.Withdraw(total); err != nil {
+		return err
+	}
+	userInventory.Add(item.Name, quantity)
+
+	fmt.Printf("Bought %d %s for $%d.\n", quantity, item.Name, total)
+	fmt.Printf("Money: $%d\n", userWallet.Balance())
+	return nil
+}
+
+func commandSell(args []string) error {
+	if len(args) == 0 {
+		return errors.New("please provide an item name")
+	}
+
+	itemName := args[0]
+	quantity, err := parseQuantity(args[1:])
+	if err != nil {
+		return err
+	}
+
+	if userInventory.Count(itemName) < quantity {
+		return fmt.Errorf("you only have %d %s", userInventory.Count(itemName), itemName)
+	}
+
+	item, err := pokeapi.GetItem(itemName, &pokeapi.Conf)
+	if err != nil {
+		return err
+	}
+
+	price := sellPrice(item.Cost)
+	if price == 0 {
+		return errors.New(item.Name + " can't be sold")
+	}
+
+	if err := userInventory.Remove(item.Name, quantity); err != nil {
+		return err
+	}
+
+	total := price * quantity
+	if err := userWallet.Deposit(total); err != nil {
+		return err
+	}
+
+	fmt.Printf("Sold %d %s for $%d.\n", quantity, item.Name, total)
+	fmt.Printf("Money: $%d\n", userWallet.Balance())
+	return nil
+}
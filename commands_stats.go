@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+// regionalDexes lists the PokeAPI pokedexes that make up each region's dex.
+// Regions split over several dexes count as one.
+var regionalDexes = []struct {
+	region string
+	dexes  []string
+}{
+	{"kanto", []string{"kanto"}},
+	{"johto", []string{"original-johto"}},
+	{"hoenn", []string{"hoenn"}},
+	{"sinnoh", []string{"original-sinnoh"}},
+	{"unova", []string{"original-unova"}},
+	{"kalos", []string{"kalos-central", "kalos-coastal", "kalos-mountain"}},
+	{"alola", []string{"original-alola"}},
+	{"galar", []string{"galar", "isle-of-armor", "crown-tundra"}},
+	{"paldea", []string{"paldea", "kitakami", "blueberry"}},
+}
+
+const (
+	progressBarWidth = 20
+	rarestShown      = 5
+)
+
+func commandStats(args []string) error {
+	entries := userPokedex.GetAll()
+	caught := pokedex.Species(entries)
+
+	national, err := pokeapi.GetPokedex("national", &pokeapi.Conf)
+	if err != nil {
+		return err
+	}
+	seen, err := seenSpecies(national.Species)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Collection: %d pokemon, %d species caught, %d seen\n", len(entries), len(caught), len(seen))
+	fmt.Printf("National Dex: %s\n", progress(pokedex.Completion(caught, national.Species), len(national.Species)))
+	fmt.Printf("National Seen: %s\n", progress(pokedex.Completion(seen, national.Species), len(national.Species)))
+
+	fmt.Println("Regional Dexes:")
+	for _, regional := range regionalDexes {
+		var species []string
+		for _, name := range regional.dexes {
+			dex, err := pokeapi.GetPokedex(name, &pokeapi.Conf)
+			if err != nil {
+				return err
+			}
+			for _, s := range dex.Species {
+				if !slices.Contains(species, s) {
+					species = append(species, s)
+				}
+			}
+		}
+		fmt.Printf("  %-7s caught %s\n", regional.region, progress(pokedex.Completion(caught, species), len(species)))
+		fmt.Printf("  %-7s seen   %s\n", "", progress(pokedex.Completion(seen, species), len(species)))
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	fmt.Println("By type:")
+	byType := pokedex.CountByType(entries)
+	types := make([]string, 0, len(byType))
+	for name := range byType {
+		types = append(types, name)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if byType[types[i]] != byType[types[j]] {
+			return byType[types[i]] > byType[types[j]]
+		}
+		return types[i] < types[j]
+	})
+	for _, name := range types {
+		fmt.Printf("  - %s: %d\n", name, byType[name])
+	}
+
+	fmt.Println("By generation:")
+	byRegion := pokedex.CountByRegion(entries)
+	for _, generation := range pokeapi.Generations {
+		if count := byRegion[generation.Region]; count > 0 {
+			fmt.Printf("  - %s (%s): %d\n", generation.Name, generation.Region, count)
+		}
+	}
+
+	fmt.Println("Average base stats:")
+	averages := pokedex.AverageBaseStats(entries)
+	for _, name := range stats.Names {
+		fmt.Printf("  -%s: %.1f\n", name, averages[name])
+	}
+
+	return printRarest(caught)
+}
+
+// seenSpecies lists the species of every Pokemon seen. Sightings are kept
+// under Pokemon names, which for forms such as deoxys-normal aren't in any
+// dex, so those are looked up.
+func seenSpecies(dexSpecies []string) ([]string, error) {
+	var species []string
+	for _, sighting := range userSeen.GetAll() {
+		name := sighting.Name
+		if !slices.Contains(dexSpecies, name) {
+			pokemon, err := pokeapi.GetPokemon(name, &pokeapi.Conf)
+			if err != nil {
+				return nil, err
+			}
+			name = pokemon.SpeciesName()
+		}
+		if !slices.Contains(species, name) {
+			species = append(species, name)
+		}
+	}
+	return species, nil
+}
+
+// printRarest lists the caught species that are hardest to catch.
+func printRarest(caught []string) error {
+	type rarity struct {
+		name        string
+		captureRate int
+	}
+
+	rarities := make([]rarity, 0, len(caught))
+	for _, name := range caught {
+		species, err := pokeapi.GetPokemonSpecies(name, &pokeapi.Conf)
+		if err != nil {
+			return err
+		}
+		rarities = append(rarities, rarity{name: name, captureRate: species.CaptureRate})
+	}
+	sort.SliceStable(rarities, func(i, j int) bool {
+		return rarities[i].captureRate < rarities[j].captureRate
+	})
+	if len(rarities) > rarestShown {
+		rarities = rarities[:rarestShown]
+	}
+
+	fmt.Println("Rarest catches:")
+	for _, r := range rarities {
+		fmt.Printf("  - %s (capture rate %d)\n", r.name, r.captureRate)
+	}
+	return nil
+}
+
+func progress(have, total int) string {
+	percent := 0.0
+	filled := 0
+	if total > 0 {
+		percent = float64(have) * 100 / float64(total)
+		filled = have * progressBarWidth / total
+	}
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", progressBarWidth-filled)
+	return fmt.Sprintf("[%s] %d/%d (%.1f%%)", bar, have, total, percent)
+}
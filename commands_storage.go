@@ -0,0 +1,151 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+)
+
+func commandParty(args []string) error {
+	members := partyEntries()
+	if len(members) == 0 {
+		fmt.Println("Your party is empty")
+		return nil
+	}
+
+	fmt.Printf("Your Party (%d/%d):\n", len(members), pokedex.PartySize)
+	for i, entry := range members {
+		fmt.Printf(" %d. %s\n", i+1, describeEntry(entry))
+	}
+	return nil
+}
+
+func commandBox(args []string) error {
+	if len(args) == 0 {
+		fmt.Println("PC Boxes:")
+		for i, box := range userStorage.Boxes() {
+			fmt.Printf(" - Box %d: %d/%d\n", i+1, len(box), pokedex.BoxSize)
+		}
+		return nil
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errors.New("box must be a number")
+	}
+
+	ids, err := userStorage.Box(n)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		fmt.Printf("Box %d is empty\n", n)
+		return nil
+	}
+
+	fmt.Printf("Box %d (%d/%d):\n", n, len(ids), pokedex.BoxSize)
+	for _, entry := range entriesFor(ids) {
+		fmt.Println(" - " + describeEntry(entry))
+	}
+	return nil
+}
+
+func commandDeposit(args []string) error {
+	entry, err := findStored(args)
+	if err != nil {
+		return err
+	}
+
+	location, err := userStorage.Deposit(entry.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s was sent to %s.\n", entry.DisplayName(), location)
+	return nil
+}
+
+func commandWithdraw(args []string) error {
+	entry, err := findStored(args)
+	if err != nil {
+		return err
+	}
+
+	location, err := userStorage.Withdraw(entry.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s joined %s.\n", entry.DisplayName(), location)
+	return nil
+}
+
+func commandMove(args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: move <id> <box>")
+	}
+
+	entry, err := findStored(args)
+	if err != nil {
+		return err
+	}
+
+	box, err := strconv.Atoi(args[1])
+	if err != nil {
+		return errors.New("box must be a number")
+	}
+
+	location, err := userStorage.MoveTo(entry.ID, box)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s was moved to %s.\n", entry.DisplayName(), location)
+	return nil
+}
+
+func commandSwap(args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: swap <pokemon> <pokemon>")
+	}
+
+	first, err := findStored(args[:1])
+	if err != nil {
+		return err
+	}
+	second, err := findStored(args[1:2])
+	if err != nil {
+		return err
+	}
+
+	if err := userStorage.Swap(first.ID, second.ID); err != nil {
+		return err
+	}
+	fmt.Printf("%s and %s swapped places.\n", first.DisplayName(), second.DisplayName())
+	return nil
+}
+
+func findStored(args []string) (pokedex.Entry, error) {
+	if len(args) == 0 {
+		return pokedex.Entry{}, errors.New("please provide a pokemon name or ID")
+	}
+
+	entry, ok := userPokedex.Find(args[0])
+	if !ok {
+		return pokedex.Entry{}, errors.New("You have not caught " + args[0])
+	}
+	return entry, nil
+}
+
+func partyEntries() []pokedex.Entry {
+	return entriesFor(userStorage.Party())
+}
+
+func entriesFor(ids []int) []pokedex.Entry {
+	entries := make([]pokedex.Entry, 0, len(ids))
+	for _, id := range ids {
+		if entry, ok := userPokedex.Get(id); ok {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
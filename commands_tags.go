@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+)
+
+func commandFavorite(args []string) error {
+	entry, err := findStored(args)
+	if err != nil {
+		return err
+	}
+
+	if entry.HasTag(pokedex.FavoriteTag) {
+		if err := retag(entry, nil, []string{pokedex.FavoriteTag}); err != nil {
+			return err
+		}
+		fmt.Printf("%s was removed from your favorites.\n", entry.DisplayName())
+		return nil
+	}
+
+	if err := retag(entry, []string{pokedex.FavoriteTag}, nil); err != nil {
+		return err
+	}
+	fmt.Printf("%s was added to your favorites.\n", entry.DisplayName())
+	return nil
+}
+
+// commandTag lists the tags in use, shows the tags of one Pokemon, or adds
+// and with a leading - removes them: tag pikachu starter -shiny-hunt
+func commandTag(args []string) error {
+	if len(args) == 0 {
+		return listTags()
+	}
+
+	entry, err := findStored(args)
+	if err != nil {
+		return err
+	}
+
+	var add, remove []string
+	for _, arg := range args[1:] {
+		if tag, ok := strings.CutPrefix(arg, "-"); ok {
+			remove = append(remove, tag)
+		} else {
+			add = append(add, strings.TrimPrefix(arg, "+"))
+		}
+	}
+
+	if len(add) > 0 || len(remove) > 0 {
+		if err := retag(entry, add, remove); err != nil {
+			return err
+		}
+		entry, _ = userPokedex.Get(entry.ID)
+	}
+
+	if len(entry.Tags) == 0 {
+		fmt.Printf("%s has no tags\n", entry.DisplayName())
+		return nil
+	}
+	fmt.Printf("%s is tagged %s\n", entry.DisplayName(), strings.Join(entry.Tags, ", "))
+	return nil
+}
+
+// retag adds and removes tags in one journal step, so a single undo reverts
+// the whole command.
+func retag(entry pokedex.Entry, add, remove []string) error {
+	tags := slices.Clone(entry.Tags)
+	tags = append(tags, add...)
+	tags = slices.DeleteFunc(tags, func(tag string) bool {
+		return slices.Contains(remove, tag)
+	})
+
+	updated, err := userPokedex.SetTags(entry.ID, tags)
+	if err != nil {
+		return err
+	}
+	userJournal.Record(pokedex.Mutation{Op: pokedex.OpRetag, Entry: updated, FromTags: entry.Tags, ToTags: updated.Tags})
+	return nil
+}
+
+func listTags() error {
+	counts := pokedex.TagCounts(userPokedex.GetAll())
+	if len(counts) == 0 {
+		fmt.Println("You haven't tagged any pokemon yet")
+		return nil
+	}
+
+	tags := make([]string, 0, len(counts))
+	for tag := range counts {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	fmt.Println("Your tags:")
+	for _, tag := range tags {
+		fmt.Printf(" - %s (%d)\n", tag, counts[tag])
+	}
+	return nil
+}
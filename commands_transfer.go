@@ -0,0 +1,164 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/transfer"
+)
+
+func commandExport(args []string) error {
+	path, flags, err := splitFlags(args, "format")
+	if err != nil {
+		return err
+	}
+
+	format := transfer.FormatFromPath(path)
+	if value, ok := flags["format"]; ok {
+		if format, err = transfer.ParseFormat(value); err != nil {
+			return err
+		}
+	}
+
+	entries := userPokedex.GetAll()
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := transfer.Export(file, format, entries); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d pokemon to %s as %s\n", len(entries), path, format)
+	return nil
+}
+
+func commandImport(args []string) error {
+	path, flags, err := splitFlags(args, "merge", "replace", "format")
+	if err != nil {
+		return err
+	}
+
+	_, merge := flags["merge"]
+	_, replace := flags["replace"]
+	if merge && replace {
+		return errors.New("choose either --merge or --replace")
+	}
+
+	format := transfer.FormatFromPath(path)
+	if value, ok := flags["format"]; ok {
+		if format, err = transfer.ParseFormat(value); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	entries, err := transfer.Import(file, format)
+	if err != nil {
+		return err
+	}
+
+	if replace {
+		return replaceCollection(entries)
+	}
+	return mergeCollection(entries)
+}
+
+func replaceCollection(entries []pokedex.Entry) error {
+	capacity := pokedex.PartySize + pokedex.BoxCount*pokedex.BoxSize
+	if len(entries) > capacity {
+		return fmt.Errorf("the file holds %d pokemon, your PC only has room for %d", len(entries), capacity)
+	}
+
+	if err := userPokedex.Replace(entries); err != nil {
+		return err
+	}
+	userJournal.Clear()
+	if err := userStorage.Restore(nil, nil); err != nil {
+		return err
+	}
+	for _, entry := range userPokedex.GetAll() {
+		if _, err := userStorage.Place(entry.ID); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Replaced your pokedex with %d pokemon\n", len(entries))
+	return nil
+}
+
+func mergeCollection(entries []pokedex.Entry) error {
+	if needed := transfer.Incoming(userPokedex, entries); needed > userStorage.Free() {
+		return fmt.Errorf("the file holds %d new pokemon, your PC only has room for %d more", needed, userStorage.Free())
+	}
+
+	report := transfer.Merge(userPokedex, entries)
+	for _, entry := range report.Added {
+		if _, err := userStorage.Place(entry.ID); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Imported %d pokemon, skipped %d already in your pokedex\n", len(report.Added), len(report.Skipped))
+	for _, conflict := range report.Conflicts {
+		fmt.Printf(" - #%d is already %s, imported %s as #%d\n",
+			conflict.Incoming.ID, describeEntry(conflict.Existing), conflict.Incoming.Pokemon.Name, conflict.NewID)
+	}
+	return nil
+}
+
+// splitFlags separates a file argument from --flag and --flag=value options.
+// A --format flag also accepts its value as the next argument. Flags not in
+// allowed are rejected. The path keeps its case, with a leading ~ meaning
+// the home directory.
+func splitFlags(args []string, allowed ...string) (string, map[string]string, error) {
+	path := ""
+	flags := make(map[string]string)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "--") {
+			if path != "" {
+				return "", nil, fmt.Errorf("unexpected argument %s", arg)
+			}
+			path = arg
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		name = strings.ToLower(name)
+		if !slices.Contains(allowed, name) {
+			return "", nil, fmt.Errorf("unknown option %s", arg)
+		}
+		if name == "format" && !hasValue && i+1 < len(args) {
+			i++
+			value = args[i]
+		}
+		flags[name] = value
+	}
+
+	if path == "" {
+		return "", nil, errors.New("please provide a file name")
+	}
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", nil, err
+		}
+		path = filepath.Join(home, rest)
+	}
+	return path, flags, nil
+}
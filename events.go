@@ -0,0 +1,43 @@
+package main
+
+import (
+	"github.com/Nachsus/pokedexcli/internal/achievements"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/quests"
+)
+
+// watchPokedex hooks the rest of the game up to changes in the collection,
+// so the commands that make them don't have to know who cares.
+func watchPokedex(dex *pokedex.Pokedex) {
+	dex.Subscribe(func(event pokedex.Event) {
+		switch e := event.(type) {
+		case pokedex.Caught:
+			markCaught(e.Entry)
+			trackCatch(e.Entry)
+		case pokedex.Restored:
+			markCaught(e.Entry)
+		case pokedex.Leveled:
+			if e.After.Level > e.Before.Level {
+				printLevelUp(e.After, e.Before.Stats())
+			}
+		}
+	})
+}
+
+// markCaught counts a caught Pokemon as seen, which matters for saves and
+// imports from before sightings were tracked.
+func markCaught(entry pokedex.Entry) {
+	userSeen.Mark(entry.Pokemon.Name, entry.Area, entry.CaughtAt)
+}
+
+// trackCatch counts a new catch toward achievements and quests. The species
+// was just fetched to meet the Pokemon, so it normally comes from the cache.
+func trackCatch(entry pokedex.Entry) {
+	legendary := false
+	if species, err := pokeapi.GetPokemonSpecies(entry.Pokemon.SpeciesName(), &pokeapi.Conf); err == nil {
+		legendary = species.IsLegendary
+	}
+	track(achievements.Catch{Species: entry.Pokemon.Name, Types: entry.Pokemon.Types, Legendary: legendary})
+	trackQuest(quests.Catch{Types: entry.Pokemon.Types})
+}
@@ -0,0 +1,100 @@
+package achievements
+
+// Milestone is a goal the trainer works toward. Count reads how far along
+// the trainer is from their progress, and the milestone unlocks once it
+// reaches Goal.
+type Milestone struct {
+	ID          string
+	Name        string
+	Description string
+	Goal        int
+	Count       func(Progress) int
+}
+
+// Starters are the first stage starter Pokemon of every generation.
+var Starters = []string{
+	"bulbasaur", "charmander", "squirtle",
+	"chikorita", "cyndaquil", "totodile",
+	"treecko", "torchic", "mudkip",
+	"turtwig", "chimchar", "piplup",
+	"snivy", "tepig", "oshawott",
+	"chespin", "fennekin", "froakie",
+	"rowlet", "litten", "popplio",
+	"grookey", "scorbunny", "sobble",
+	"sprigatito", "fuecoco", "quaxly",
+}
+
+// Milestones lists every achievement in the order they are shown.
+var Milestones = []Milestone{
+	{
+		ID:          "first-catch",
+		Name:        "First Catch",
+		Description: "Catch your first Pokemon",
+		Goal:        1,
+		Count:       func(p Progress) int { return p.Catches },
+	},
+	{
+		ID:          "type-specialist",
+		Name:        "Type Specialist",
+		Description: "Catch 10 Pokemon of one type",
+		Goal:        10,
+		Count:       bestType,
+	},
+	{
+		ID:          "starter-collector",
+		Name:        "Starter Collector",
+		Description: "Catch every starter Pokemon",
+		Goal:        len(Starters),
+		Count:       speciesFrom(Starters),
+	},
+	{
+		ID:          "legend",
+		Name:        "Legend",
+		Description: "Catch a legendary Pokemon",
+		Goal:        1,
+		Count:       func(p Progress) int { return p.Legendary },
+	},
+	{
+		ID:          "persistence",
+		Name:        "Persistence",
+		Description: "Have 100 Pokemon break free",
+		Goal:        100,
+		Count:       func(p Progress) int { return p.Escapes },
+	},
+	{
+		ID:          "explorer",
+		Name:        "Explorer",
+		Description: "Explore 10 different areas",
+		Goal:        10,
+		Count:       func(p Progress) int { return len(p.Areas) },
+	},
+}
+
+func Get(id string) (Milestone, bool) {
+	for _, milestone := range Milestones {
+		if milestone.ID == id {
+			return milestone, true
+		}
+	}
+	return Milestone{}, false
+}
+
+func bestType(p Progress) int {
+	best := 0
+	for _, count := range p.Types {
+		best = max(best, count)
+	}
+	return best
+}
+
+func speciesFrom(names []string) func(Progress) int {
+	return func(p Progress) int {
+		count := 0
+		for _, name := range names {
+			if p.Species[name] {
+				count++
+			}
+		}
+		return count
+	}
+}
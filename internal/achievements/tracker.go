@@ -0,0 +1,168 @@
+package achievements
+
+import (
+	"maps"
+	"sync"
+	"time"
+)
+
+// Outcome is something that happened in the game and counts toward
+// milestones: a Catch, an Escape or an Explore.
+type Outcome interface {
+	isOutcome()
+}
+
+type Catch struct {
+	Species   string
+	Types     []string
+	Legendary bool
+}
+
+// Escape is a Pokemon that broke free of a ball.
+type Escape struct {
+	Species string
+}
+
+type Explore struct {
+	Area string
+}
+
+func (Catch) isOutcome()   {}
+func (Escape) isOutcome()  {}
+func (Explore) isOutcome() {}
+
+// Progress is everything milestones are counted from. Unlike the pokedex it
+// only ever grows, so releasing a Pokemon never takes progress away.
+type Progress struct {
+	Catches   int
+	Escapes   int
+	Legendary int
+	Types     map[string]int
+	Species   map[string]bool
+	Areas     map[string]bool
+}
+
+func (p Progress) clone() Progress {
+	p.Types = maps.Clone(p.Types)
+	p.Species = maps.Clone(p.Species)
+	p.Areas = maps.Clone(p.Areas)
+	if p.Types == nil {
+		p.Types = make(map[string]int)
+	}
+	if p.Species == nil {
+		p.Species = make(map[string]bool)
+	}
+	if p.Areas == nil {
+		p.Areas = make(map[string]bool)
+	}
+	return p
+}
+
+// Status is how far along a milestone is.
+type Status struct {
+	Milestone  Milestone
+	Count      int
+	UnlockedAt time.Time
+}
+
+func (s Status) Unlocked() bool {
+	return !s.UnlockedAt.IsZero()
+}
+
+type Tracker struct {
+	mu       sync.Mutex
+	progress Progress
+	unlocked map[string]time.Time
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		progress: Progress{}.clone(),
+		unlocked: make(map[string]time.Time),
+	}
+}
+
+// Restore puts back progress and unlocks read from a save. Milestones the
+// progress already reaches are unlocked at the given time, such as for saves
+// from before achievements.
+func (t *Tracker) Restore(progress Progress, unlocked map[string]time.Time, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress = progress.clone()
+	t.unlocked = maps.Clone(unlocked)
+	if t.unlocked == nil {
+		t.unlocked = make(map[string]time.Time)
+	}
+	t.unlock(at)
+}
+
+// Record counts outcome and returns the milestones it unlocked.
+func (t *Tracker) Record(outcome Outcome, at time.Time) []Milestone {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch o := outcome.(type) {
+	case Catch:
+		t.progress.Catches++
+		t.progress.Species[o.Species] = true
+		for _, name := range o.Types {
+			t.progress.Types[name]++
+		}
+		if o.Legendary {
+			t.progress.Legendary++
+		}
+	case Escape:
+		t.progress.Escapes++
+	case Explore:
+		t.progress.Areas[o.Area] = true
+	}
+	return t.unlock(at)
+}
+
+func (t *Tracker) unlock(at time.Time) []Milestone {
+	var unlocked []Milestone
+	for _, milestone := range Milestones {
+		if _, done := t.unlocked[milestone.ID]; done {
+			continue
+		}
+		if milestone.Count(t.progress) >= milestone.Goal {
+			t.unlocked[milestone.ID] = at
+			unlocked = append(unlocked, milestone)
+		}
+	}
+	return unlocked
+}
+
+func (t *Tracker) Progress() Progress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.progress.clone()
+}
+
+func (t *Tracker) Unlocked() map[string]time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.unlocked)
+}
+
+// Statuses reports every milestone in order. Counts never go past the goal,
+// and an unlocked milestone always counts as complete.
+func (t *Tracker) Statuses() []Status {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	statuses := make([]Status, 0, len(Milestones))
+	for _, milestone := range Milestones {
+		status := Status{
+			Milestone:  milestone,
+			Count:      min(milestone.Count(t.progress), milestone.Goal),
+			UnlockedAt: t.unlocked[milestone.ID],
+		}
+		if status.Unlocked() {
+			status.Count = milestone.Goal
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
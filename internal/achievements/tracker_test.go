@@ -0,0 +1,131 @@
+package achievements
+
+import (
+	"testing"
+	"time"
+)
+
+var now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func ids(milestones []Milestone) []string {
+	var out []string
+	for _, milestone := range milestones {
+		out = append(out, milestone.ID)
+	}
+	return out
+}
+
+func TestRecordUnlocksOnce(t *testing.T) {
+	tracker := NewTracker()
+
+	unlocked := tracker.Record(Catch{Species: "pikachu", Types: []string{"electric"}}, now)
+	if got := ids(unlocked); len(got) != 1 || got[0] != "first-catch" {
+		t.Fatalf("expected first-catch to unlock, got %v", got)
+	}
+
+	if unlocked := tracker.Record(Catch{Species: "pichu", Types: []string{"electric"}}, now); len(unlocked) != 0 {
+		t.Errorf("expected nothing new to unlock, got %v", ids(unlocked))
+	}
+}
+
+func TestTypeSpecialist(t *testing.T) {
+	tracker := NewTracker()
+	for i := 0; i < 9; i++ {
+		tracker.Record(Catch{Species: "zubat", Types: []string{"poison", "flying"}}, now)
+		tracker.Record(Catch{Species: "pidgey", Types: []string{"normal", "flying"}}, now)
+	}
+
+	// Flying is already at 18, so type-specialist unlocked along the way
+	if _, ok := tracker.Unlocked()["type-specialist"]; !ok {
+		t.Error("expected type-specialist once one type reached 10")
+	}
+}
+
+func TestLegendaryEscapesAndExplore(t *testing.T) {
+	tracker := NewTracker()
+
+	if got := ids(tracker.Record(Catch{Species: "mewtwo", Types: []string{"psychic"}, Legendary: true}, now)); len(got) != 2 {
+		t.Errorf("expected first-catch and legend, got %v", got)
+	}
+
+	for i := 0; i < 99; i++ {
+		tracker.Record(Escape{Species: "abra"}, now)
+	}
+	if _, ok := tracker.Unlocked()["persistence"]; ok {
+		t.Fatal("expected persistence to need 100 escapes")
+	}
+	if got := ids(tracker.Record(Escape{Species: "abra"}, now)); len(got) != 1 || got[0] != "persistence" {
+		t.Errorf("expected persistence, got %v", got)
+	}
+
+	// Exploring the same area twice only counts once
+	for i := 0; i < 10; i++ {
+		tracker.Record(Explore{Area: "viridian-forest-area"}, now)
+	}
+	if got := tracker.Progress(); len(got.Areas) != 1 {
+		t.Errorf("expected 1 area explored, got %d", len(got.Areas))
+	}
+}
+
+func TestStarterCollector(t *testing.T) {
+	tracker := NewTracker()
+	for _, name := range Starters[:len(Starters)-1] {
+		tracker.Record(Catch{Species: name, Types: []string{"grass"}}, now)
+	}
+
+	var status Status
+	for _, s := range tracker.Statuses() {
+		if s.Milestone.ID == "starter-collector" {
+			status = s
+		}
+	}
+	if status.Count != len(Starters)-1 || status.Unlocked() {
+		t.Errorf("expected %d/%d and still locked, got %+v", len(Starters)-1, len(Starters), status)
+	}
+
+	last := Starters[len(Starters)-1]
+	if got := ids(tracker.Record(Catch{Species: last, Types: []string{"water"}}, now)); len(got) != 1 || got[0] != "starter-collector" {
+		t.Errorf("expected starter-collector, got %v", got)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	tracker := NewTracker()
+	later := now.Add(time.Hour)
+
+	tracker.Restore(Progress{Catches: 3}, map[string]time.Time{"legend": now}, later)
+
+	unlocked := tracker.Unlocked()
+	if !unlocked["legend"].Equal(now) {
+		t.Errorf("expected legend to keep its unlock time, got %v", unlocked["legend"])
+	}
+	if !unlocked["first-catch"].Equal(later) {
+		t.Errorf("expected first-catch to unlock on restore, got %v", unlocked["first-catch"])
+	}
+
+	// An unlocked milestone stays complete even if its count went down
+	for _, status := range tracker.Statuses() {
+		if status.Milestone.ID == "legend" && status.Count != 1 {
+			t.Errorf("expected legend to show as complete, got %d", status.Count)
+		}
+	}
+
+	// Restored maps must be safe to record into
+	tracker.Record(Catch{Species: "eevee", Types: []string{"normal"}}, now)
+}
+
+func TestMilestonesAreUnique(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, milestone := range Milestones {
+		if seen[milestone.ID] {
+			t.Errorf("duplicate milestone %s", milestone.ID)
+		}
+		seen[milestone.ID] = true
+		if milestone.Goal < 1 || milestone.Count == nil {
+			t.Errorf("%s: needs a goal and a count", milestone.ID)
+		}
+		if got, ok := Get(milestone.ID); !ok || got.Name != milestone.Name {
+			t.Errorf("%s: expected Get to find it", milestone.ID)
+		}
+	}
+}
@@ -0,0 +1,371 @@
+package battle
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/Nachsus/pokedexcli/internal/capture"
+)
+
+type Outcome int
+
+const (
+	Ongoing Outcome = iota
+	Won
+	Lost
+	Fled
+	Caught
+)
+
+// Battle is a single wild encounter. All randomness goes through rng so a
+// battle replays identically under the same seed.
+type Battle struct {
+	Party          []*Combatant
+	Wild           *Combatant
+	Turn           int
+	active         int
+	outcome        Outcome
+	mustSwitch     bool
+	escapeAttempts int
+	rng            *rand.Rand
+}
+
+func New(party []*Combatant, wild *Combatant, rng *rand.Rand) (*Battle, error) {
+	b := &Battle{
+		Party:  party,
+		Wild:   wild,
+		Turn:   1,
+		active: -1,
+		rng:    rng,
+	}
+
+	for i, c := range party {
+		if !c.Fainted() {
+			b.active = i
+			break
+		}
+	}
+	if b.active < 0 {
+		return nil, errors.New("you have no pokemon that can fight")
+	}
+
+	return b, nil
+}
+
+func (b *Battle) Active() *Combatant {
+	return b.Party[b.active]
+}
+
+func (b *Battle) Outcome() Outcome {
+	return b.outcome
+}
+
+func (b *Battle) Over() bool {
+	return b.outcome != Ongoing
+}
+
+func (b *Battle) MustSwitch() bool {
+	return b.mustSwitch
+}
+
+func (b *Battle) ready() error {
+	if b.Over() {
+		return errors.New("the battle is over")
+	}
+	if b.mustSwitch {
+		return errors.New("choose your next pokemon with switch")
+	}
+	return nil
+}
+
+func (b *Battle) label(c *Combatant) string {
+	if c == b.Wild {
+		return "wild " + c.Name
+	}
+	return c.Name
+}
+
+func (b *Battle) Fight(moveName string) ([]string, error) {
+	if err := b.ready(); err != nil {
+		return nil, err
+	}
+
+	player := b.Active()
+	playerSlot := -1
+	if player.hasPP() {
+		for i, slot := range player.Moves {
+			if slot.Move.Name == moveName {
+				playerSlot = i
+			}
+		}
+		if playerSlot < 0 {
+			return nil, fmt.Errorf("%s doesn't know %s", player.Name, moveName)
+		}
+		if player.Moves[playerSlot].PP == 0 {
+			return nil, fmt.Errorf("%s has no PP left", moveName)
+		}
+	}
+
+	wildSlot := b.chooseWildMove()
+
+	var log []string
+	if b.playerFirst(moveAt(player, playerSlot), moveAt(b.Wild, wildSlot)) {
+		log = append(log, b.useMove(player, b.Wild, playerSlot)...)
+		if !b.resolveFaints(&log) {
+			log = append(log, b.useMove(b.Wild, player, wildSlot)...)
+			b.resolveFaints(&log)
+		}
+	} else {
+		log = append(log, b.useMove(b.Wild, player, wildSlot)...)
+		if !b.resolveFaints(&log) {
+			log = append(log, b.useMove(player, b.Wild, playerSlot)...)
+			b.resolveFaints(&log)
+		}
+	}
+
+	b.endTurn(&log)
+	return log, nil
+}
+
+func (b *Battle) Switch(name string) ([]string, error) {
+	for i, c := range b.Party {
+		if c.Name == name {
+			return b.SwitchSlot(i)
+		}
+	}
+	return nil, errors.New(name + " is not in your party")
+}
+
+// SwitchSlot switches to the party member at index, which tells apart two
+// party members that share a name.
+func (b *Battle) SwitchSlot(index int) ([]string, error) {
+	if b.Over() {
+		return nil, errors.New("the battle is over")
+	}
+	if index < 0 || index >= len(b.Party) {
+		return nil, errors.New("there's no pokemon in that party slot")
+	}
+
+	c := b.Party[index]
+	if index == b.active {
+		return nil, errors.New(c.Name + " is already in battle")
+	}
+	if c.Fainted() {
+		return nil, errors.New(c.Name + " has fainted and can't battle")
+	}
+
+	b.active = index
+	log := []string{fmt.Sprintf("Go! %s!", c.Name)}
+
+	if b.mustSwitch {
+		b.mustSwitch = false
+		return log, nil
+	}
+
+	log = append(log, b.EnemyTurn()...)
+	return log, nil
+}
+
+func (b *Battle) Run() ([]string, error) {
+	if err := b.ready(); err != nil {
+		return nil, err
+	}
+
+	b.escapeAttempts++
+	if b.escapes() {
+		b.outcome = Fled
+		return []string{"Got away safely!"}, nil
+	}
+
+	log := []string{"Can't escape!"}
+	log = append(log, b.EnemyTurn()...)
+	return log, nil
+}
+
+// escapes uses the generation III/IV escape odds, which improve with every
+// failed attempt.
+func (b *Battle) escapes() bool {
+	playerSpeed, wildSpeed := b.Active().speed(), b.Wild.speed()
+	if playerSpeed >= wildSpeed {
+		return true
+	}
+
+	divisor := (wildSpeed / 4) % 256
+	if divisor == 0 {
+		return true
+	}
+
+	odds := playerSpeed*32/divisor + 30*b.escapeAttempts
+	if odds > 255 {
+		return true
+	}
+	return b.rng.Intn(256) < odds
+}
+
+// EnemyTurn lets the wild Pokemon act after the player spent their turn on
+// something other than a move, such as switching or throwing a ball.
+func (b *Battle) EnemyTurn() []string {
+	if b.Over() {
+		return nil
+	}
+
+	var log []string
+	log = append(log, b.useMove(b.Wild, b.Active(), b.chooseWildMove())...)
+	b.resolveFaints(&log)
+	b.endTurn(&log)
+	return log
+}
+
+// CatchTarget describes the wild Pokemon in its current state for a throw.
+func (b *Battle) CatchTarget(captureRate int) capture.Target {
+	return capture.Target{
+		CaptureRate: captureRate,
+		MaxHP:       b.Wild.MaxHP,
+		CurrentHP:   b.Wild.HP,
+		Status:      b.Wild.Status,
+		Level:       b.Wild.Level,
+		Types:       b.Wild.Types,
+	}
+}
+
+func (b *Battle) MarkCaught() {
+	b.outcome = Caught
+}
+
+func moveAt(c *Combatant, slot int) Move {
+	if slot < 0 {
+		return Struggle
+	}
+	return c.Moves[slot].Move
+}
+
+func (b *Battle) chooseWildMove() int {
+	var usable []int
+	for i, slot := range b.Wild.Moves {
+		if slot.PP > 0 {
+			usable = append(usable, i)
+		}
+	}
+	if len(usable) == 0 {
+		return -1
+	}
+	return usable[b.rng.Intn(len(usable))]
+}
+
+func (b *Battle) playerFirst(playerMove, wildMove Move) bool {
+	if playerMove.Priority != wildMove.Priority {
+		return playerMove.Priority > wildMove.Priority
+	}
+
+	playerSpeed, wildSpeed := b.Active().speed(), b.Wild.speed()
+	if playerSpeed != wildSpeed {
+		return playerSpeed > wildSpeed
+	}
+	return b.rng.Intn(2) == 0
+}
+
+func (b *Battle) useMove(attacker, defender *Combatant, slot int) []string {
+	var log []string
+	if !b.canMove(attacker, &log) {
+		return log
+	}
+
+	move := moveAt(attacker, slot)
+	if slot >= 0 {
+		attacker.Moves[slot].PP--
+	}
+	log = append(log, fmt.Sprintf("%s used %s!", b.label(attacker), move.Name))
+
+	if move.Accuracy > 0 && b.rng.Intn(100) >= move.Accuracy {
+		return append(log, fmt.Sprintf("%s's attack missed!", b.label(attacker)))
+	}
+
+	if move.Power > 0 {
+		critical := b.rng.Intn(criticalChance) == 0
+		random := 85 + b.rng.Intn(16)
+		damage, effectiveness := Damage(attacker, defender, move, critical, random)
+		if effectiveness == 0 {
+			return append(log, fmt.Sprintf("It doesn't affect %s...", b.label(defender)))
+		}
+
+		defender.takeDamage(damage)
+		if critical {
+			log = append(log, "A critical hit!")
+		}
+		if effectiveness > 1 {
+			log = append(log, "It's super effective!")
+		} else if effectiveness < 1 {
+			log = append(log, "It's not very effective...")
+		}
+
+		if slot < 0 {
+			recoil := max(1, attacker.MaxHP/4)
+			attacker.takeDamage(recoil)
+			log = append(log, fmt.Sprintf("%s is hit with recoil!", b.label(attacker)))
+		}
+	}
+
+	if move.Ailment != capture.StatusNone && !defender.Fainted() {
+		chance := move.AilmentChance
+		if chance == 0 && move.Category == "status" {
+			chance = 100
+		}
+		if chance > 0 && b.rng.Intn(100) < chance {
+			log = append(log, b.inflict(defender, move.Ailment)...)
+		}
+	} else if move.Power == 0 {
+		log = append(log, "But nothing happened!")
+	}
+
+	return log
+}
+
+// resolveFaints reports fainted Pokemon and returns true when the turn
+// should stop because one side can no longer act.
+func (b *Battle) resolveFaints(log *[]string) bool {
+	stopped := false
+
+	if b.Wild.Fainted() && !b.Over() {
+		*log = append(*log, fmt.Sprintf("%s fainted!", b.label(b.Wild)))
+		b.outcome = Won
+		stopped = true
+	}
+
+	active := b.Active()
+	if active.Fainted() && !b.mustSwitch && b.outcome != Lost {
+		*log = append(*log, fmt.Sprintf("%s fainted!", active.Name))
+		stopped = true
+		if b.Over() {
+			return stopped
+		}
+
+		for _, c := range b.Party {
+			if !c.Fainted() {
+				b.mustSwitch = true
+				*log = append(*log, "Choose your next pokemon with switch.")
+				return stopped
+			}
+		}
+
+		b.outcome = Lost
+		*log = append(*log, "You have no more pokemon that can fight!")
+	}
+
+	return stopped
+}
+
+func (b *Battle) endTurn(log *[]string) {
+	if b.Over() {
+		return
+	}
+
+	for _, c := range []*Combatant{b.Active(), b.Wild} {
+		if c.Fainted() {
+			continue
+		}
+		*log = append(*log, b.residual(c)...)
+	}
+	b.resolveFaints(log)
+	b.Turn++
+}
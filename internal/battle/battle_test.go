@@ -0,0 +1,259 @@
+package battle
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/capture"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+var (
+	tackle      = Move{Name: "tackle", Type: "normal", Power: 40, Accuracy: 100, PP: 35, Category: "physical"}
+	quickAttack = Move{Name: "quick-attack", Type: "normal", Power: 40, Accuracy: 100, PP: 30, Priority: 1, Category: "physical"}
+	thunderWave = Move{Name: "thunder-wave", Type: "electric", Accuracy: 100, PP: 20, Category: "status", Ailment: capture.StatusParalysis}
+)
+
+func newTestBattle(seed int64) *Battle {
+	base := map[string]int{"hp": 50, "attack": 60, "defense": 50, "special-attack": 50, "special-defense": 50, "speed": 80}
+	slowBase := map[string]int{"hp": 50, "attack": 60, "defense": 50, "special-attack": 50, "special-defense": 50, "speed": 30}
+
+	party := []*Combatant{
+		NewCombatant("pikachu", 20, []string{"electric"}, base, []Move{tackle, quickAttack, thunderWave}),
+		NewCombatant("eevee", 20, []string{"normal"}, base, []Move{tackle}),
+	}
+	wild := NewCombatant("rattata", 18, []string{"normal"}, slowBase, []Move{tackle})
+
+	b, _ := New(party, wild, rand.New(rand.NewSource(seed)))
+	return b
+}
+
+func TestNew_NoHealthyPokemon(t *testing.T) {
+	fainted := testCombatant("pikachu", []string{"electric"})
+	fainted.HP = 0
+
+	_, err := New([]*Combatant{fainted}, testCombatant("rattata", nil), rand.New(rand.NewSource(1)))
+	if err == nil {
+		t.Error("expected error when the whole party has fainted")
+	}
+}
+
+func TestFight_Deterministic(t *testing.T) {
+	play := func() []string {
+		b := newTestBattle(99)
+		var all []string
+		for !b.Over() && b.Turn < 50 {
+			log, err := b.Fight("tackle")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			all = append(all, log...)
+		}
+		return all
+	}
+
+	first, second := play(), play()
+	if !reflect.DeepEqual(first, second) {
+		t.Error("expected identical battle logs for the same seed")
+	}
+}
+
+func TestFight_FasterPokemonMovesFirst(t *testing.T) {
+	b := newTestBattle(1)
+
+	log, err := b.Fight("tackle")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if log[0] != "pikachu used tackle!" {
+		t.Errorf("expected the faster pikachu to move first, got %q", log[0])
+	}
+}
+
+func TestFight_PriorityBeatsSpeed(t *testing.T) {
+	b := newTestBattle(1)
+	b.Wild.Moves = []MoveSlot{{Move: quickAttack, PP: 30}}
+
+	log, err := b.Fight("tackle")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if log[0] != "wild rattata used quick-attack!" {
+		t.Errorf("expected priority move to go first, got %q", log[0])
+	}
+}
+
+func TestFight_UnknownMove(t *testing.T) {
+	b := newTestBattle(1)
+
+	if _, err := b.Fight("hyper-beam"); err == nil {
+		t.Error("expected error for a move pikachu doesn't know")
+	}
+}
+
+func TestFight_UsesPP(t *testing.T) {
+	b := newTestBattle(1)
+
+	b.Fight("tackle")
+	if got := b.Active().Moves[0].PP; got != 34 {
+		t.Errorf("expected 34 PP left, got %d", got)
+	}
+}
+
+func TestFight_StatusMoveInflictsAilment(t *testing.T) {
+	b := newTestBattle(3)
+
+	b.Fight("thunder-wave")
+	if b.Wild.Status != capture.StatusParalysis {
+		t.Errorf("expected wild rattata to be paralyzed, got %q", b.Wild.Status)
+	}
+}
+
+func TestFight_WinsWhenWildFaints(t *testing.T) {
+	b := newTestBattle(1)
+	b.Wild.HP = 1
+
+	b.Fight("tackle")
+	if b.Outcome() != Won {
+		t.Errorf("expected battle to be won, got %v", b.Outcome())
+	}
+
+	if _, err := b.Fight("tackle"); err == nil {
+		t.Error("expected error fighting after the battle ended")
+	}
+}
+
+func TestFaintForcesSwitch(t *testing.T) {
+	b := newTestBattle(1)
+	b.Active().HP = 1
+	b.Wild.Moves = []MoveSlot{{Move: quickAttack, PP: 30}}
+
+	b.Fight("tackle")
+	if !b.MustSwitch() {
+		t.Fatal("expected a forced switch after pikachu fainted")
+	}
+
+	if _, err := b.Fight("tackle"); err == nil {
+		t.Error("expected error fighting before switching")
+	}
+
+	if _, err := b.Switch("pikachu"); err == nil {
+		t.Error("expected error switching to a fainted pokemon")
+	}
+
+	log, err := b.Switch("eevee")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The forced switch doesn't give the wild pokemon a free turn
+	if len(log) != 1 {
+		t.Errorf("expected only the switch message, got %v", log)
+	}
+}
+
+func TestSwitchSlot(t *testing.T) {
+	b := newTestBattle(1)
+
+	if _, err := b.SwitchSlot(0); err == nil {
+		t.Error("expected error switching to the active pokemon")
+	}
+
+	if _, err := b.SwitchSlot(5); err == nil {
+		t.Error("expected error for an empty party slot")
+	}
+
+	log, err := b.SwitchSlot(1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if log[0] != "Go! eevee!" {
+		t.Errorf("expected eevee to be sent out, got %q", log[0])
+	}
+
+	// A voluntary switch costs the turn
+	if len(log) < 2 {
+		t.Errorf("expected the wild pokemon to act after the switch, got %v", log)
+	}
+}
+
+func TestLoseWhenPartyFaints(t *testing.T) {
+	b := newTestBattle(1)
+	b.Party[1].HP = 0
+	b.Active().HP = 1
+	b.Wild.Moves = []MoveSlot{{Move: quickAttack, PP: 30}}
+
+	b.Fight("tackle")
+	if b.Outcome() != Lost {
+		t.Errorf("expected battle to be lost, got %v", b.Outcome())
+	}
+}
+
+func TestRun_FasterAlwaysEscapes(t *testing.T) {
+	b := newTestBattle(1)
+
+	if _, err := b.Run(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if b.Outcome() != Fled {
+		t.Errorf("expected to flee, got %v", b.Outcome())
+	}
+}
+
+func TestUseItem(t *testing.T) {
+	b := newTestBattle(1)
+	b.Active().HP = 10
+
+	log, err := b.UseItem("potion", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if log[1] != "pikachu recovered 20 HP." {
+		t.Errorf("expected potion to restore 20 HP, got %q", log[1])
+	}
+
+	if _, err := b.UseItem("potion", "eevee"); err == nil {
+		t.Error("expected error healing a pokemon at full HP")
+	}
+
+	if _, err := b.UseItem("rare-candy", ""); err == nil {
+		t.Error("expected error for an item with no battle effect")
+	}
+}
+
+func TestCatchTarget(t *testing.T) {
+	b := newTestBattle(1)
+	b.Wild.HP = 5
+	b.Wild.Status = capture.StatusSleep
+
+	target := b.CatchTarget(255)
+	if target.CurrentHP != 5 || target.MaxHP != b.Wild.MaxHP {
+		t.Errorf("expected current HP to carry over, got %d/%d", target.CurrentHP, target.MaxHP)
+	}
+	if target.Status != capture.StatusSleep {
+		t.Errorf("expected sleep status, got %q", target.Status)
+	}
+}
+
+func TestMovesForLevel(t *testing.T) {
+	learnset := []pokeapi.LearnedMove{
+		{Name: "tackle", Level: 1},
+		{Name: "growl", Level: 1},
+		{Name: "ember", Level: 4},
+		{Name: "smokescreen", Level: 8},
+		{Name: "dragon-breath", Level: 12},
+		{Name: "flamethrower", Level: 30},
+	}
+
+	moves := MovesForLevel(learnset, 12)
+	expected := []string{"growl", "ember", "smokescreen", "dragon-breath"}
+	if !reflect.DeepEqual(moves, expected) {
+		t.Errorf("expected %v, got %v", expected, moves)
+	}
+}
@@ -0,0 +1,139 @@
+package battle
+
+import (
+	"slices"
+
+	"github.com/Nachsus/pokedexcli/internal/capture"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+const maxMoves = 4
+
+type Move struct {
+	Name          string
+	Type          string
+	Power         int
+	Accuracy      int
+	PP            int
+	Priority      int
+	Category      string
+	Ailment       capture.Status
+	AilmentChance int
+}
+
+// Struggle is used once a Pokemon has no PP left in any of its moves.
+var Struggle = Move{
+	Name:     "struggle",
+	Power:    50,
+	Category: "physical",
+}
+
+func NewMove(m *pokeapi.Move) Move {
+	ailment := capture.StatusNone
+	switch capture.Status(m.Ailment) {
+	case capture.StatusSleep, capture.StatusFreeze, capture.StatusParalysis, capture.StatusBurn, capture.StatusPoison:
+		ailment = capture.Status(m.Ailment)
+	}
+
+	return Move{
+		Name:          m.Name,
+		Type:          m.Type,
+		Power:         m.Power,
+		Accuracy:      m.Accuracy,
+		PP:            m.PP,
+		Priority:      m.Priority,
+		Category:      m.DamageClass,
+		Ailment:       ailment,
+		AilmentChance: m.AilmentChance,
+	}
+}
+
+type MoveSlot struct {
+	Move Move
+	PP   int
+}
+
+type Combatant struct {
+	Name       string
+	Level      int
+	Types      []string
+	Stats      map[string]int
+	MaxHP      int
+	HP         int
+	Status     capture.Status
+	Moves      []MoveSlot
+	sleepTurns int
+}
+
+func NewCombatant(name string, level int, types []string, baseStats map[string]int, moves []Move) *Combatant {
+	return NewCombatantWithStats(name, level, types, stats.Calculate(baseStats, nil, nil, level, ""), moves)
+}
+
+// NewCombatantWithStats builds a combatant from already calculated stats.
+func NewCombatantWithStats(name string, level int, types []string, actual map[string]int, moves []Move) *Combatant {
+	slots := make([]MoveSlot, 0, maxMoves)
+	for _, move := range moves {
+		if len(slots) == maxMoves {
+			break
+		}
+		slots = append(slots, MoveSlot{Move: move, PP: move.PP})
+	}
+
+	return &Combatant{
+		Name:  name,
+		Level: level,
+		Types: types,
+		Stats: actual,
+		MaxHP: actual["hp"],
+		HP:    actual["hp"],
+		Moves: slots,
+	}
+}
+
+func (c *Combatant) Fainted() bool {
+	return c.HP <= 0
+}
+
+func (c *Combatant) speed() int {
+	if c.Status == capture.StatusParalysis {
+		return c.Stats["speed"] / 2
+	}
+	return c.Stats["speed"]
+}
+
+func (c *Combatant) hasPP() bool {
+	for _, slot := range c.Moves {
+		if slot.PP > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Combatant) takeDamage(amount int) {
+	c.HP = max(0, c.HP-amount)
+}
+
+func (c *Combatant) heal(amount int) int {
+	before := c.HP
+	c.HP = min(c.MaxHP, c.HP+amount)
+	return c.HP - before
+}
+
+// MovesForLevel picks the four most recently learned level-up moves, the same
+// way a wild Pokemon's moveset is chosen in the games.
+func MovesForLevel(learnset []pokeapi.LearnedMove, level int) []string {
+	var names []string
+	for _, move := range learnset {
+		if move.Level > level || slices.Contains(names, move.Name) {
+			continue
+		}
+		names = append(names, move.Name)
+	}
+
+	if len(names) > maxMoves {
+		names = names[len(names)-maxMoves:]
+	}
+	return names
+}
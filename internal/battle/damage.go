@@ -0,0 +1,45 @@
+package battle
+
+import (
+	"slices"
+
+	"github.com/Nachsus/pokedexcli/internal/capture"
+)
+
+const criticalChance = 24
+
+func baseDamage(level, power, attack, defense int) int {
+	return (2*level/5+2)*power*attack/defense/50 + 2
+}
+
+// Damage applies the generation V+ damage formula. random is the roll
+// between 85 and 100 that scales the final damage.
+func Damage(attacker, defender *Combatant, move Move, critical bool, random int) (int, float64) {
+	attack, defense := attacker.Stats["attack"], defender.Stats["defense"]
+	if move.Category == "special" {
+		attack, defense = attacker.Stats["special-attack"], defender.Stats["special-defense"]
+	}
+	if defense <= 0 {
+		defense = 1
+	}
+
+	damage := baseDamage(attacker.Level, move.Power, attack, defense)
+	if critical {
+		damage = damage * 3 / 2
+	}
+	damage = damage * random / 100
+	if move.Type != "" && slices.Contains(attacker.Types, move.Type) {
+		damage = damage * 3 / 2
+	}
+
+	effectiveness := Effectiveness(move.Type, defender.Types)
+	damage = int(float64(damage) * effectiveness)
+
+	if attacker.Status == capture.StatusBurn && move.Category == "physical" {
+		damage /= 2
+	}
+	if effectiveness > 0 && damage < 1 {
+		damage = 1
+	}
+	return damage, effectiveness
+}
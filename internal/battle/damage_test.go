@@ -0,0 +1,73 @@
+package battle
+
+import (
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/capture"
+)
+
+func testCombatant(name string, types []string) *Combatant {
+	return NewCombatantWithStats(name, 50, types, map[string]int{
+		"hp":              100,
+		"attack":          100,
+		"defense":         100,
+		"special-attack":  100,
+		"special-defense": 100,
+		"speed":           100,
+	}, nil)
+}
+
+func TestDamage_Neutral(t *testing.T) {
+	attacker := testCombatant("attacker", []string{"normal"})
+	defender := testCombatant("defender", []string{"normal"})
+	move := Move{Name: "ember", Type: "fire", Power: 40, Category: "special"}
+
+	// (2*50/5+2) * 40 * 100/100 / 50 + 2 = 19
+	damage, effectiveness := Damage(attacker, defender, move, false, 100)
+	if damage != 19 {
+		t.Errorf("expected 19 damage, got %d", damage)
+	}
+	if effectiveness != 1 {
+		t.Errorf("expected neutral effectiveness, got %v", effectiveness)
+	}
+}
+
+func TestDamage_Modifiers(t *testing.T) {
+	attacker := testCombatant("attacker", []string{"fire"})
+	defender := testCombatant("defender", []string{"grass"})
+	move := Move{Name: "ember", Type: "fire", Power: 40, Category: "special"}
+
+	// 19 -> crit 28 -> random 85% 23 -> STAB 34 -> super effective 68
+	damage, effectiveness := Damage(attacker, defender, move, true, 85)
+	if damage != 68 {
+		t.Errorf("expected 68 damage, got %d", damage)
+	}
+	if effectiveness != 2 {
+		t.Errorf("expected super effective, got %v", effectiveness)
+	}
+}
+
+func TestDamage_BurnHalvesPhysical(t *testing.T) {
+	attacker := testCombatant("attacker", []string{"normal"})
+	defender := testCombatant("defender", []string{"water"})
+	tackle := Move{Name: "tackle", Type: "normal", Power: 40, Category: "physical"}
+
+	healthy, _ := Damage(attacker, defender, tackle, false, 100)
+	attacker.Status = capture.StatusBurn
+	burned, _ := Damage(attacker, defender, tackle, false, 100)
+
+	if burned >= healthy {
+		t.Errorf("expected burn to reduce physical damage, got %d >= %d", burned, healthy)
+	}
+}
+
+func TestDamage_Immune(t *testing.T) {
+	attacker := testCombatant("attacker", []string{"normal"})
+	defender := testCombatant("defender", []string{"ghost"})
+	tackle := Move{Name: "tackle", Type: "normal", Power: 40, Category: "physical"}
+
+	damage, effectiveness := Damage(attacker, defender, tackle, false, 100)
+	if damage != 0 || effectiveness != 0 {
+		t.Errorf("expected no damage against ghost, got %d (%v)", damage, effectiveness)
+	}
+}
@@ -0,0 +1,120 @@
+package battle
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Nachsus/pokedexcli/internal/capture"
+)
+
+type itemEffect struct {
+	heal   int
+	full   bool
+	revive bool
+	cures  []capture.Status
+}
+
+var allStatuses = []capture.Status{
+	capture.StatusSleep,
+	capture.StatusFreeze,
+	capture.StatusParalysis,
+	capture.StatusBurn,
+	capture.StatusPoison,
+}
+
+var itemEffects = map[string]itemEffect{
+	"potion":        {heal: 20},
+	"super-potion":  {heal: 60},
+	"hyper-potion":  {heal: 120},
+	"max-potion":    {full: true},
+	"full-restore":  {full: true, cures: allStatuses},
+	"antidote":      {cures: []capture.Status{capture.StatusPoison}},
+	"paralyze-heal": {cures: []capture.Status{capture.StatusParalysis}},
+	"awakening":     {cures: []capture.Status{capture.StatusSleep}},
+	"burn-heal":     {cures: []capture.Status{capture.StatusBurn}},
+	"ice-heal":      {cures: []capture.Status{capture.StatusFreeze}},
+	"full-heal":     {cures: allStatuses},
+	"revive":        {revive: true},
+	"max-revive":    {revive: true, full: true},
+}
+
+func IsBattleItem(name string) bool {
+	_, ok := itemEffects[name]
+	return ok
+}
+
+// UseItem applies a healing item to a party member, defaulting to the active
+// Pokemon, then lets the wild Pokemon take its turn.
+func (b *Battle) UseItem(item string, target string) ([]string, error) {
+	if err := b.ready(); err != nil {
+		return nil, err
+	}
+
+	effect, ok := itemEffects[item]
+	if !ok {
+		return nil, errors.New(item + " can't be used in battle")
+	}
+
+	c := b.Active()
+	if target != "" {
+		c = nil
+		for _, member := range b.Party {
+			if member.Name == target {
+				c = member
+				break
+			}
+		}
+		if c == nil {
+			return nil, errors.New(target + " is not in your party")
+		}
+	}
+
+	log, err := b.applyItem(c, item, effect)
+	if err != nil {
+		return nil, err
+	}
+
+	log = append(log, b.EnemyTurn()...)
+	return log, nil
+}
+
+func (b *Battle) applyItem(c *Combatant, item string, effect itemEffect) ([]string, error) {
+	if effect.revive {
+		if !c.Fainted() {
+			return nil, errors.New(c.Name + " hasn't fainted")
+		}
+		c.Status = capture.StatusNone
+		if effect.full {
+			c.HP = c.MaxHP
+		} else {
+			c.HP = max(1, c.MaxHP/2)
+		}
+		return []string{fmt.Sprintf("%s was revived!", c.Name)}, nil
+	}
+
+	if c.Fainted() {
+		return nil, errors.New(c.Name + " has fainted")
+	}
+
+	cures := slices.Contains(effect.cures, c.Status)
+	heals := (effect.heal > 0 || effect.full) && c.HP < c.MaxHP
+	if !cures && !heals {
+		return nil, errors.New("it won't have any effect")
+	}
+
+	log := []string{fmt.Sprintf("You used a %s on %s.", item, c.Name)}
+	if heals {
+		amount := effect.heal
+		if effect.full {
+			amount = c.MaxHP
+		}
+		restored := c.heal(amount)
+		log = append(log, fmt.Sprintf("%s recovered %d HP.", c.Name, restored))
+	}
+	if cures {
+		c.Status = capture.StatusNone
+		log = append(log, fmt.Sprintf("%s was cured.", c.Name))
+	}
+	return log, nil
+}
@@ -0,0 +1,78 @@
+package battle
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Nachsus/pokedexcli/internal/capture"
+)
+
+var statusImmunities = map[capture.Status][]string{
+	capture.StatusParalysis: {"electric"},
+	capture.StatusBurn:      {"fire"},
+	capture.StatusFreeze:    {"ice"},
+	capture.StatusPoison:    {"poison", "steel"},
+}
+
+var statusMessages = map[capture.Status]string{
+	capture.StatusSleep:     "%s fell asleep!",
+	capture.StatusFreeze:    "%s was frozen solid!",
+	capture.StatusParalysis: "%s is paralyzed! It may be unable to move!",
+	capture.StatusBurn:      "%s was burned!",
+	capture.StatusPoison:    "%s was poisoned!",
+}
+
+func (b *Battle) inflict(c *Combatant, status capture.Status) []string {
+	if c.Status != capture.StatusNone {
+		return nil
+	}
+	for _, immune := range statusImmunities[status] {
+		if slices.Contains(c.Types, immune) {
+			return nil
+		}
+	}
+
+	c.Status = status
+	if status == capture.StatusSleep {
+		c.sleepTurns = 1 + b.rng.Intn(3)
+	}
+	return []string{fmt.Sprintf(statusMessages[status], b.label(c))}
+}
+
+func (b *Battle) canMove(c *Combatant, log *[]string) bool {
+	switch c.Status {
+	case capture.StatusSleep:
+		if c.sleepTurns > 0 {
+			c.sleepTurns--
+			*log = append(*log, fmt.Sprintf("%s is fast asleep.", b.label(c)))
+			return false
+		}
+		c.Status = capture.StatusNone
+		*log = append(*log, fmt.Sprintf("%s woke up!", b.label(c)))
+	case capture.StatusFreeze:
+		if b.rng.Intn(5) != 0 {
+			*log = append(*log, fmt.Sprintf("%s is frozen solid!", b.label(c)))
+			return false
+		}
+		c.Status = capture.StatusNone
+		*log = append(*log, fmt.Sprintf("%s thawed out!", b.label(c)))
+	case capture.StatusParalysis:
+		if b.rng.Intn(4) == 0 {
+			*log = append(*log, fmt.Sprintf("%s is paralyzed! It can't move!", b.label(c)))
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Battle) residual(c *Combatant) []string {
+	switch c.Status {
+	case capture.StatusBurn:
+		c.takeDamage(max(1, c.MaxHP/16))
+		return []string{fmt.Sprintf("%s is hurt by its burn!", b.label(c))}
+	case capture.StatusPoison:
+		c.takeDamage(max(1, c.MaxHP/8))
+		return []string{fmt.Sprintf("%s is hurt by poison!", b.label(c))}
+	}
+	return nil
+}
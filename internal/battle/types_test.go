@@ -0,0 +1,26 @@
+package battle
+
+import "testing"
+
+func TestEffectiveness(t *testing.T) {
+	tests := []struct {
+		moveType string
+		defender []string
+		expected float64
+	}{
+		{"water", []string{"fire"}, 2},
+		{"fire", []string{"water"}, 0.5},
+		{"electric", []string{"ground"}, 0},
+		{"normal", []string{"normal"}, 1},
+		{"ice", []string{"dragon", "flying"}, 4},
+		{"fire", []string{"water", "rock"}, 0.25},
+		{"", []string{"ghost"}, 1},
+	}
+
+	for _, tt := range tests {
+		got := Effectiveness(tt.moveType, tt.defender)
+		if got != tt.expected {
+			t.Errorf("%s vs %v: expected %v, got %v", tt.moveType, tt.defender, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package capture
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+type Ball struct {
+	Name       string
+	guaranteed bool
+	modifier   func(target Target, ctx Context) float64
+}
+
+func flat(bonus float64) func(Target, Context) float64 {
+	return func(Target, Context) float64 {
+		return bonus
+	}
+}
+
+var balls = map[string]Ball{
+	"poke-ball":    {Name: "poke-ball", modifier: flat(1)},
+	"great-ball":   {Name: "great-ball", modifier: flat(1.5)},
+	"ultra-ball":   {Name: "ultra-ball", modifier: flat(2)},
+	"master-ball":  {Name: "master-ball", guaranteed: true, modifier: flat(255)},
+	"premier-ball": {Name: "premier-ball", modifier: flat(1)},
+	"luxury-ball":  {Name: "luxury-ball", modifier: flat(1)},
+	"heal-ball":    {Name: "heal-ball", modifier: flat(1)},
+	"net-ball": {Name: "net-ball", modifier: func(t Target, _ Context) float64 {
+		if slices.Contains(t.Types, "water") || slices.Contains(t.Types, "bug") {
+			return 3.5
+		}
+		return 1
+	}},
+	"nest-ball": {Name: "nest-ball", modifier: func(t Target, _ Context) float64 {
+		if t.Level <= 0 {
+			return 1
+		}
+		return max(1, float64(41-t.Level)/10)
+	}},
+	"repeat-ball": {Name: "repeat-ball", modifier: func(_ Target, ctx Context) float64 {
+		if ctx.AlreadyCaught {
+			return 3.5
+		}
+		return 1
+	}},
+	"timer-ball": {Name: "timer-ball", modifier: func(_ Target, ctx Context) float64 {
+		return min(4, 1+float64(ctx.Turn)*1229/4096)
+	}},
+	"quick-ball": {Name: "quick-ball", modifier: func(_ Target, ctx Context) float64 {
+		if ctx.Turn <= 1 {
+			return 5
+		}
+		return 1
+	}},
+	"dusk-ball": {Name: "dusk-ball", modifier: func(_ Target, ctx Context) float64 {
+		if ctx.Night || ctx.InCave {
+			return 3.5
+		}
+		return 1
+	}},
+}
+
+// GetBall looks up a ball by its PokeAPI item name, also accepting the short
+// form without the "-ball" suffix.
+func GetBall(name string) (Ball, bool) {
+	if !strings.HasSuffix(name, "-ball") {
+		name += "-ball"
+	}
+	ball, ok := balls[name]
+	return ball, ok
+}
+
+func BallNames() []string {
+	names := make([]string, 0, len(balls))
+	for name := range balls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
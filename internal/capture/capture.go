@@ -0,0 +1,147 @@
+package capture
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+type Mode string
+
+const (
+	ModeAuthentic Mode = "authentic"
+	ModeLegacy    Mode = "legacy"
+)
+
+func ParseMode(name string) (Mode, error) {
+	switch Mode(name) {
+	case ModeAuthentic, ModeLegacy:
+		return Mode(name), nil
+	default:
+		return "", errors.New("unknown catch mode, expected authentic or legacy")
+	}
+}
+
+type Status string
+
+const (
+	StatusNone      Status = ""
+	StatusSleep     Status = "sleep"
+	StatusFreeze    Status = "freeze"
+	StatusParalysis Status = "paralysis"
+	StatusBurn      Status = "burn"
+	StatusPoison    Status = "poison"
+)
+
+func (s Status) bonus() float64 {
+	switch s {
+	case StatusSleep, StatusFreeze:
+		return 2.0
+	case StatusParalysis, StatusBurn, StatusPoison:
+		return 1.5
+	default:
+		return 1.0
+	}
+}
+
+// Target describes the wild Pokemon a ball is thrown at. A zero MaxHP is
+// treated as full health.
+type Target struct {
+	CaptureRate int
+	MaxHP       int
+	CurrentHP   int
+	Status      Status
+	Level       int
+	Types       []string
+}
+
+// Context holds the situational details some balls care about. Bonus is
+// extra catch power on top of the ball, such as 0.2 for 20% more.
+type Context struct {
+	Turn          int
+	Night         bool
+	InCave        bool
+	AlreadyCaught bool
+	Bonus         float64
+}
+
+type Result struct {
+	Shakes int
+	Caught bool
+	Chance float64
+}
+
+const (
+	shakeChecks = 4
+	maxShakes   = 3
+)
+
+// Throw runs the generation III/IV capture formula: a modified catch rate is
+// derived from HP, species rate, ball and status, then four shake checks
+// against a 16-bit threshold decide the outcome.
+func Throw(target Target, ball Ball, ctx Context, rng *rand.Rand) Result {
+	if ball.guaranteed {
+		return Result{Shakes: maxShakes, Caught: true, Chance: 1}
+	}
+
+	a := modifiedRate(target, ball, ctx)
+	if a >= 255 {
+		return Result{Shakes: maxShakes, Caught: true, Chance: 1}
+	}
+
+	b := shakeThreshold(a)
+	chance := math.Pow(float64(b)/65536, shakeChecks)
+
+	shakes := 0
+	for i := 0; i < shakeChecks; i++ {
+		if rng.Intn(65536) >= b {
+			return Result{Shakes: min(shakes, maxShakes), Caught: false, Chance: chance}
+		}
+		shakes++
+	}
+
+	return Result{Shakes: maxShakes, Caught: true, Chance: chance}
+}
+
+func modifiedRate(target Target, ball Ball, ctx Context) float64 {
+	maxHP := float64(target.MaxHP)
+	currentHP := float64(target.CurrentHP)
+	if maxHP <= 0 {
+		maxHP, currentHP = 1, 1
+	}
+
+	a := ((3*maxHP - 2*currentHP) * float64(target.CaptureRate) * ball.modifier(target, ctx)) / (3 * maxHP)
+	return math.Floor(a * target.Status.bonus() * (1 + ctx.Bonus))
+}
+
+func shakeThreshold(a float64) int {
+	if a < 1 {
+		a = 1
+	}
+	return int(1048560 / math.Sqrt(math.Sqrt(16711680/a)))
+}
+
+// LegacyChance is the original base-experience formula, returned as a
+// percentage between 30 and 80.
+func LegacyChance(baseExperience int) float64 {
+	const minChance = 30.0
+	const maxChance = 80.0
+	const maxBaseXP = 608.0
+
+	catchChance := maxChance - ((float64(baseExperience) / maxBaseXP) * (maxChance - minChance))
+	if catchChance < minChance {
+		catchChance = minChance
+	}
+	if catchChance > maxChance {
+		catchChance = maxChance
+	}
+	return catchChance
+}
+
+// ThrowLegacy throws with the original formula. bonus raises the chance as
+// Context.Bonus does for Throw.
+func ThrowLegacy(baseExperience int, bonus float64, rng *rand.Rand) Result {
+	chance := min(LegacyChance(baseExperience)*(1+bonus), 100)
+	roll := rng.Float64() * 100.0
+	return Result{Caught: roll <= chance, Chance: chance / 100.0}
+}
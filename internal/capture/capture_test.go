@@ -0,0 +1,162 @@
+package capture
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestThrow_MasterBallAlwaysCatches(t *testing.T) {
+	ball, _ := GetBall("master-ball")
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		result := Throw(Target{CaptureRate: 3}, ball, Context{}, rng)
+		if !result.Caught {
+			t.Fatalf("expected master ball to always catch, failed on throw %d", i)
+		}
+	}
+}
+
+func TestThrow_HighRateCatchesWithoutChecks(t *testing.T) {
+	ball, _ := GetBall("ultra-ball")
+	rng := rand.New(rand.NewSource(1))
+
+	// capture rate 255 at 1 HP with an ultra ball is well over the threshold
+	target := Target{CaptureRate: 255, MaxHP: 100, CurrentHP: 1}
+	result := Throw(target, ball, Context{}, rng)
+	if !result.Caught {
+		t.Error("expected guaranteed catch")
+	}
+	if result.Chance != 1 {
+		t.Errorf("expected chance 1, got %f", result.Chance)
+	}
+}
+
+func TestThrow_DeterministicWithSeed(t *testing.T) {
+	ball, _ := GetBall("poke-ball")
+	target := Target{CaptureRate: 45, MaxHP: 50, CurrentHP: 30}
+
+	first := Throw(target, ball, Context{}, rand.New(rand.NewSource(42)))
+	second := Throw(target, ball, Context{}, rand.New(rand.NewSource(42)))
+
+	if first != second {
+		t.Errorf("expected identical results for the same seed, got %+v and %+v", first, second)
+	}
+}
+
+func TestThrow_ShakesNeverExceedThree(t *testing.T) {
+	ball, _ := GetBall("poke-ball")
+	rng := rand.New(rand.NewSource(7))
+	target := Target{CaptureRate: 45}
+
+	for i := 0; i < 500; i++ {
+		result := Throw(target, ball, Context{}, rng)
+		if result.Shakes < 0 || result.Shakes > 3 {
+			t.Fatalf("expected 0-3 shakes, got %d", result.Shakes)
+		}
+		if result.Caught && result.Shakes != 3 {
+			t.Fatalf("expected 3 shakes on a catch, got %d", result.Shakes)
+		}
+	}
+}
+
+func TestThrow_ChanceImprovesWithDamageStatusAndBall(t *testing.T) {
+	poke, _ := GetBall("poke-ball")
+	ultra, _ := GetBall("ultra-ball")
+	rng := rand.New(rand.NewSource(1))
+
+	full := Throw(Target{CaptureRate: 45, MaxHP: 100, CurrentHP: 100}, poke, Context{}, rng)
+	hurt := Throw(Target{CaptureRate: 45, MaxHP: 100, CurrentHP: 10}, poke, Context{}, rng)
+	asleep := Throw(Target{CaptureRate: 45, MaxHP: 100, CurrentHP: 100, Status: StatusSleep}, poke, Context{}, rng)
+	better := Throw(Target{CaptureRate: 45, MaxHP: 100, CurrentHP: 100}, ultra, Context{}, rng)
+	boosted := Throw(Target{CaptureRate: 45, MaxHP: 100, CurrentHP: 100}, poke, Context{Bonus: 0.2}, rng)
+
+	if hurt.Chance <= full.Chance {
+		t.Errorf("expected lower HP to improve chance, got %f <= %f", hurt.Chance, full.Chance)
+	}
+	if asleep.Chance <= full.Chance {
+		t.Errorf("expected sleep to improve chance, got %f <= %f", asleep.Chance, full.Chance)
+	}
+	if better.Chance <= full.Chance {
+		t.Errorf("expected ultra ball to improve chance, got %f <= %f", better.Chance, full.Chance)
+	}
+	if boosted.Chance <= full.Chance {
+		t.Errorf("expected a bonus to improve chance, got %f <= %f", boosted.Chance, full.Chance)
+	}
+}
+
+func TestSituationalBalls(t *testing.T) {
+	tests := []struct {
+		ball     string
+		target   Target
+		ctx      Context
+		expected float64
+	}{
+		{"net-ball", Target{Types: []string{"water"}}, Context{}, 3.5},
+		{"net-ball", Target{Types: []string{"fire"}}, Context{}, 1},
+		{"nest-ball", Target{Level: 11}, Context{}, 3},
+		{"nest-ball", Target{Level: 50}, Context{}, 1},
+		{"repeat-ball", Target{}, Context{AlreadyCaught: true}, 3.5},
+		{"quick-ball", Target{}, Context{Turn: 1}, 5},
+		{"quick-ball", Target{}, Context{Turn: 2}, 1},
+		{"timer-ball", Target{}, Context{Turn: 30}, 4},
+		{"dusk-ball", Target{}, Context{Night: true}, 3.5},
+		{"dusk-ball", Target{}, Context{}, 1},
+	}
+
+	for _, tt := range tests {
+		ball, ok := GetBall(tt.ball)
+		if !ok {
+			t.Fatalf("expected ball %s to exist", tt.ball)
+		}
+		got := ball.modifier(tt.target, tt.ctx)
+		if got != tt.expected {
+			t.Errorf("%s: expected modifier %v, got %v", tt.ball, tt.expected, got)
+		}
+	}
+}
+
+func TestGetBall_ShortName(t *testing.T) {
+	ball, ok := GetBall("great")
+	if !ok {
+		t.Fatal("expected to find great ball by short name")
+	}
+	if ball.Name != "great-ball" {
+		t.Errorf("expected great-ball, got %s", ball.Name)
+	}
+
+	if _, ok := GetBall("fake-ball"); ok {
+		t.Error("expected unknown ball to be missing")
+	}
+}
+
+func TestLegacyChance(t *testing.T) {
+	if got := LegacyChance(0); got != 80 {
+		t.Errorf("expected 80, got %f", got)
+	}
+	if got := LegacyChance(608); got != 30 {
+		t.Errorf("expected 30, got %f", got)
+	}
+	if got := LegacyChance(1000); got != 30 {
+		t.Errorf("expected clamp to 30, got %f", got)
+	}
+}
+
+func TestThrowLegacy_Bonus(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	if got := ThrowLegacy(608, 0.2, rng).Chance; got < 0.359 || got > 0.361 {
+		t.Errorf("expected a 20%% bonus to raise 30%% to 36%%, got %f", got)
+	}
+	if got := ThrowLegacy(0, 0.3, rng).Chance; got != 1 {
+		t.Errorf("expected the chance to cap at 100%%, got %f", got)
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	if _, err := ParseMode("legacy"); err != nil {
+		t.Errorf("expected legacy to parse, got %v", err)
+	}
+	if _, err := ParseMode("bogus"); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
@@ -0,0 +1,61 @@
+package events
+
+import "sync"
+
+// Bus delivers events to every subscriber in the order they subscribed.
+// Publish calls handlers synchronously, outside the bus lock, so a handler
+// may subscribe, unsubscribe or publish again.
+type Bus[T any] struct {
+	mu       sync.Mutex
+	handlers map[int]func(T)
+	order    []int
+	nextID   int
+}
+
+func NewBus[T any]() *Bus[T] {
+	return &Bus[T]{
+		handlers: make(map[int]func(T)),
+	}
+}
+
+// Subscribe registers handler and returns a function that removes it again.
+func (b *Bus[T]) Subscribe(handler func(T)) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.handlers[id] = handler
+	b.order = append(b.order, id)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { b.unsubscribe(id) })
+	}
+}
+
+func (b *Bus[T]) unsubscribe(id int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.handlers, id)
+	for i, candidate := range b.order {
+		if candidate == id {
+			b.order = append(b.order[:i:i], b.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (b *Bus[T]) Publish(event T) {
+	b.mu.Lock()
+	handlers := make([]func(T), 0, len(b.order))
+	for _, id := range b.order {
+		handlers = append(handlers, b.handlers[id])
+	}
+	b.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
@@ -0,0 +1,84 @@
+package events
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPublishInSubscribeOrder(t *testing.T) {
+	bus := NewBus[string]()
+
+	var got []string
+	bus.Subscribe(func(e string) { got = append(got, "first:"+e) })
+	bus.Subscribe(func(e string) { got = append(got, "second:"+e) })
+
+	bus.Publish("caught")
+
+	expected := []string{"first:caught", "second:caught"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	bus := NewBus[int]()
+
+	calls := 0
+	cancel := bus.Subscribe(func(int) { calls++ })
+	bus.Publish(1)
+	cancel()
+	cancel()
+	bus.Publish(2)
+
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestHandlerCanSubscribe(t *testing.T) {
+	bus := NewBus[int]()
+
+	late := 0
+	bus.Subscribe(func(int) {
+		bus.Subscribe(func(int) { late++ })
+	})
+
+	bus.Publish(1)
+	if late != 0 {
+		t.Errorf("expected a new subscriber to miss the event being delivered, got %d calls", late)
+	}
+
+	bus.Publish(2)
+	if late != 1 {
+		t.Errorf("expected the new subscriber to get the next event, got %d calls", late)
+	}
+}
+
+func TestConcurrentPublish(t *testing.T) {
+	bus := NewBus[int]()
+
+	var mu sync.Mutex
+	total := 0
+	bus.Subscribe(func(n int) {
+		mu.Lock()
+		total += n
+		mu.Unlock()
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cancel := bus.Subscribe(func(int) {})
+			bus.Publish(1)
+			cancel()
+		}()
+	}
+	wg.Wait()
+
+	if total != 50 {
+		t.Errorf("expected 50 events, got %d", total)
+	}
+}
@@ -0,0 +1,43 @@
+package experience
+
+const MaxLevel = 100
+
+// Curve is a growth rate's experience table: the total experience needed to
+// reach each level, starting with level 1.
+type Curve []int
+
+// ForLevel returns the total experience a Pokemon has on reaching level.
+func (c Curve) ForLevel(level int) int {
+	if len(c) == 0 || level < 1 {
+		return 0
+	}
+	if level > len(c) {
+		level = len(c)
+	}
+	return c[level-1]
+}
+
+// Level returns the highest level reached with xp total experience.
+func (c Curve) Level(xp int) int {
+	level := 1
+	for i, needed := range c {
+		if xp < needed {
+			break
+		}
+		level = i + 1
+	}
+	return level
+}
+
+func (c Curve) MaxExperience() int {
+	if len(c) == 0 {
+		return 0
+	}
+	return c[len(c)-1]
+}
+
+// CatchYield is the experience each party Pokemon earns when a wild Pokemon
+// is caught, the same amount defeating it would give.
+func CatchYield(baseExperience, level int) int {
+	return max(1, baseExperience*level/7)
+}
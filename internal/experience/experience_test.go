@@ -0,0 +1,61 @@
+package experience
+
+import "testing"
+
+func mediumFast() Curve {
+	curve := make(Curve, MaxLevel)
+	for level := 1; level <= MaxLevel; level++ {
+		curve[level-1] = level * level * level
+	}
+	curve[0] = 0
+	return curve
+}
+
+func TestForLevel(t *testing.T) {
+	curve := mediumFast()
+
+	if got := curve.ForLevel(1); got != 0 {
+		t.Errorf("expected level 1 to need no experience, got %d", got)
+	}
+
+	if got := curve.ForLevel(10); got != 1000 {
+		t.Errorf("expected 1000, got %d", got)
+	}
+
+	if got := curve.ForLevel(150); got != 1000000 {
+		t.Errorf("expected levels past the table to clamp to 1000000, got %d", got)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	curve := mediumFast()
+
+	tests := []struct {
+		xp    int
+		level int
+	}{
+		{0, 1},
+		{7, 1},
+		{8, 2},
+		{999, 9},
+		{1000, 10},
+		{2000000, 100},
+	}
+
+	for _, tt := range tests {
+		if got := curve.Level(tt.xp); got != tt.level {
+			t.Errorf("Level(%d): expected %d, got %d", tt.xp, tt.level, got)
+		}
+	}
+}
+
+func TestCatchYield(t *testing.T) {
+	// Pikachu, base experience 112, caught at level 10
+	if got := CatchYield(112, 10); got != 160 {
+		t.Errorf("expected 160, got %d", got)
+	}
+
+	if got := CatchYield(0, 2); got != 1 {
+		t.Errorf("expected a minimum of 1, got %d", got)
+	}
+}
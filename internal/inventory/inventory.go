@@ -0,0 +1,83 @@
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+type Inventory struct {
+	mu    sync.Mutex
+	items map[string]int
+}
+
+func NewInventory() *Inventory {
+	return &Inventory{
+		items: make(map[string]int),
+	}
+}
+
+func (i *Inventory) Add(name string, quantity int) {
+	if quantity <= 0 {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.items[name] += quantity
+}
+
+func (i *Inventory) Remove(name string, quantity int) error {
+	if quantity <= 0 {
+		return errors.New("quantity must be positive")
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	have := i.items[name]
+	if have < quantity {
+		return fmt.Errorf("you only have %d %s", have, name)
+	}
+
+	if have == quantity {
+		delete(i.items, name)
+	} else {
+		i.items[name] = have - quantity
+	}
+	return nil
+}
+
+func (i *Inventory) Count(name string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.items[name]
+}
+
+func (i *Inventory) Has(name string) bool {
+	return i.Count(name) > 0
+}
+
+func (i *Inventory) GetAll() map[string]int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	all := make(map[string]int, len(i.items))
+	for name, quantity := range i.items {
+		all[name] = quantity
+	}
+	return all
+}
+
+func (i *Inventory) Names() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	names := make([]string, 0, len(i.items))
+	for name := range i.items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
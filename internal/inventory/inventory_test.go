@@ -0,0 +1,81 @@
+package inventory
+
+import "testing"
+
+func TestAddAndCount(t *testing.T) {
+	inv := NewInventory()
+
+	inv.Add("poke-ball", 5)
+	inv.Add("poke-ball", 3)
+
+	if got := inv.Count("poke-ball"); got != 8 {
+		t.Errorf("expected 8 poke-balls, got %d", got)
+	}
+
+	if inv.Count("potion") != 0 {
+		t.Error("expected no potions")
+	}
+}
+
+func TestAddIgnoresNonPositive(t *testing.T) {
+	inv := NewInventory()
+
+	inv.Add("potion", 0)
+	inv.Add("potion", -2)
+
+	if inv.Has("potion") {
+		t.Error("expected no potions after non-positive adds")
+	}
+}
+
+func TestRemove(t *testing.T) {
+	inv := NewInventory()
+	inv.Add("potion", 2)
+
+	if err := inv.Remove("potion", 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := inv.Count("potion"); got != 1 {
+		t.Errorf("expected 1 potion, got %d", got)
+	}
+
+	if err := inv.Remove("potion", 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, ok := inv.GetAll()["potion"]; ok {
+		t.Error("expected empty stack to be removed")
+	}
+}
+
+func TestRemoveTooMany(t *testing.T) {
+	inv := NewInventory()
+	inv.Add("potion", 1)
+
+	if err := inv.Remove("potion", 2); err == nil {
+		t.Error("expected error when removing more than owned")
+	}
+
+	if got := inv.Count("potion"); got != 1 {
+		t.Errorf("expected failed remove to leave 1 potion, got %d", got)
+	}
+}
+
+func TestNamesSorted(t *testing.T) {
+	inv := NewInventory()
+	inv.Add("ultra-ball", 1)
+	inv.Add("antidote", 1)
+	inv.Add("poke-ball", 1)
+
+	names := inv.Names()
+	expected := []string{"antidote", "poke-ball", "ultra-ball"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %d names, got %d", len(expected), len(names))
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("expected %s at %d, got %s", expected[i], i, names[i])
+		}
+	}
+}
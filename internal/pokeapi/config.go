@@ -5,6 +5,13 @@ type config struct {
 	mapNextUrl     string
 	mapPrevUrl     string
 	pokemonBaseUrl string
+	speciesBaseUrl string
+	itemBaseUrl    string
+	moveBaseUrl    string
+	growthBaseUrl  string
+	natureBaseUrl  string
+	pokedexBaseUrl string
+	typeBaseUrl    string
 }
 
 var Conf = config{
@@ -12,4 +19,36 @@ var Conf = config{
 	mapNextUrl:     "",
 	mapPrevUrl:     "",
 	pokemonBaseUrl: "https://pokeapi.co/api/v2/pokemon/",
+	speciesBaseUrl: "https://pokeapi.co/api/v2/pokemon-species/",
+	itemBaseUrl:    "https://pokeapi.co/api/v2/item/",
+	moveBaseUrl:    "https://pokeapi.co/api/v2/move/",
+	growthBaseUrl:  "https://pokeapi.co/api/v2/growth-rate/",
+	natureBaseUrl:  "https://pokeapi.co/api/v2/nature/",
+	pokedexBaseUrl: "https://pokeapi.co/api/v2/pokedex/",
+	typeBaseUrl:    "https://pokeapi.co/api/v2/type/",
+}
+
+// baseURL is where resources of a kind, named as in PokeAPI's paths, live.
+func (c *config) baseURL(kind string) (string, bool) {
+	switch kind {
+	case "location-area":
+		return c.mapBaseUrl, true
+	case "pokemon":
+		return c.pokemonBaseUrl, true
+	case "pokemon-species":
+		return c.speciesBaseUrl, true
+	case "item":
+		return c.itemBaseUrl, true
+	case "move":
+		return c.moveBaseUrl, true
+	case "growth-rate":
+		return c.growthBaseUrl, true
+	case "nature":
+		return c.natureBaseUrl, true
+	case "pokedex":
+		return c.pokedexBaseUrl, true
+	case "type":
+		return c.typeBaseUrl, true
+	}
+	return "", false
 }
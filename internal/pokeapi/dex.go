@@ -0,0 +1,48 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Pokedex is one of the games' Pokedexes, such as the national dex or a
+// regional one, listing species in dex order.
+type Pokedex struct {
+	Name    string
+	Region  string
+	Species []string
+}
+
+type pokedexAPIResponse struct {
+	Name           string            `json:"name"`
+	Region         *namedResourceAPI `json:"region"`
+	PokemonEntries []struct {
+		PokemonSpecies namedResourceAPI `json:"pokemon_species"`
+	} `json:"pokemon_entries"`
+}
+
+func GetPokedex(name string, c *config) (*Pokedex, error) {
+	body, err := fetch(c.pokedexBaseUrl + name)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("pokedex not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse pokedexAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	species := make([]string, len(apiResponse.PokemonEntries))
+	for i, entry := range apiResponse.PokemonEntries {
+		species[i] = entry.PokemonSpecies.Name
+	}
+
+	return &Pokedex{
+		Name:    apiResponse.Name,
+		Region:  resourceName(apiResponse.Region),
+		Species: species,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetPokedex(t *testing.T) {
+	// Create a test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/kanto":
+			w.Write([]byte(`{"name":"kanto","region":{"name":"kanto","url":""},"pokemon_entries":[
+				{"entry_number":1,"pokemon_species":{"name":"bulbasaur","url":""}},
+				{"entry_number":2,"pokemon_species":{"name":"ivysaur","url":""}}
+			]}`))
+		case "/national":
+			w.Write([]byte(`{"name":"national","region":null,"pokemon_entries":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		pokedexBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	dex, err := GetPokedex("kanto", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if dex.Region != "kanto" {
+		t.Errorf("Expected region 'kanto', got %s", dex.Region)
+	}
+
+	if len(dex.Species) != 2 || dex.Species[1] != "ivysaur" {
+		t.Errorf("Expected [bulbasaur ivysaur], got %v", dex.Species)
+	}
+
+	national, err := GetPokedex("national", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if national.Region != "" {
+		t.Errorf("Expected the national dex to have no region, got %s", national.Region)
+	}
+
+	_, err = GetPokedex("orre", testConfig)
+	if err == nil || err.Error() != "pokedex not found" {
+		t.Errorf("Expected 'pokedex not found', got %v", err)
+	}
+}
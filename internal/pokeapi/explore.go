@@ -3,8 +3,6 @@ package pokeapi
 import (
 	"encoding/json"
 	"errors"
-	"io"
-	"net/http"
 )
 
 type LocationAreaDetail struct {
@@ -12,7 +10,23 @@ type LocationAreaDetail struct {
 }
 
 type PokemonEncounter struct {
-	Pokemon Pokemon `json:"pokemon"`
+	Pokemon        Pokemon                  `json:"pokemon"`
+	VersionDetails []EncounterVersionDetail `json:"version_details"`
+}
+
+type EncounterVersionDetail struct {
+	EncounterDetails []EncounterDetail `json:"encounter_details"`
+}
+
+type EncounterDetail struct {
+	MinLevel int `json:"min_level"`
+	MaxLevel int `json:"max_level"`
+}
+
+type Encounter struct {
+	Name     string
+	MinLevel int
+	MaxLevel int
 }
 
 type Pokemon struct {
@@ -21,44 +35,56 @@ type Pokemon struct {
 }
 
 func GetPokemonFromArea(area string, c *config) ([]string, error) {
-	url := c.mapBaseUrl + area
-	if data, ok := cache.Get(url); ok {
-		var response LocationAreaDetail
-		if err := json.Unmarshal(data, &response); err == nil {
-			var pokemonNames []string
-			for _, encounter := range response.PokemonEncounters {
-				pokemonNames = append(pokemonNames, encounter.Pokemon.Name)
-			}
-			return pokemonNames, nil
-		}
+	body, err := fetch(c.mapBaseUrl + area)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrUnavailable
 	}
-
-	res, err := http.Get(url)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return nil, errors.New("failed to getch data from PokeAPI")
+	var response LocationAreaDetail
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	var pokemonNames []string
+	for _, encounter := range response.PokemonEncounters {
+		pokemonNames = append(pokemonNames, encounter.Pokemon.Name)
 	}
 
-	body, err := io.ReadAll(res.Body)
+	return pokemonNames, nil
+}
+
+func GetAreaEncounters(area string, c *config) ([]Encounter, error) {
+	body, err := fetch(c.mapBaseUrl + area)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("location area not found")
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	cache.Add(url, body)
-
 	var response LocationAreaDetail
 	if err := json.Unmarshal(body, &response); err != nil {
 		return nil, err
 	}
 
-	var pokemonNames []string
-	for _, encounter := range response.PokemonEncounters {
-		pokemonNames = append(pokemonNames, encounter.Pokemon.Name)
+	encounters := make([]Encounter, 0, len(response.PokemonEncounters))
+	for _, pe := range response.PokemonEncounters {
+		encounter := Encounter{Name: pe.Pokemon.Name}
+		for _, version := range pe.VersionDetails {
+			for _, detail := range version.EncounterDetails {
+				if encounter.MinLevel == 0 || detail.MinLevel < encounter.MinLevel {
+					encounter.MinLevel = detail.MinLevel
+				}
+				if detail.MaxLevel > encounter.MaxLevel {
+					encounter.MaxLevel = detail.MaxLevel
+				}
+			}
+		}
+		encounters = append(encounters, encounter)
 	}
 
-	return pokemonNames, nil
+	return encounters, nil
 }
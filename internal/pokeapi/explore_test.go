@@ -179,3 +179,39 @@ func TestGetPokemonFromArea_Cache(t *testing.T) {
 		t.Error("cached pokemon name differs from original")
 	}
 }
+
+func TestGetAreaEncounters(t *testing.T) {
+	// Create a test server with level ranges across two versions
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"pokemon_encounters": [
+			{"pokemon": {"name": "tentacool", "url": ""}, "version_details": [
+				{"encounter_details": [{"min_level": 20, "max_level": 30}]},
+				{"encounter_details": [{"min_level": 15, "max_level": 25}, {"min_level": 30, "max_level": 35}]}
+			]},
+			{"pokemon": {"name": "magikarp", "url": ""}, "version_details": []}
+		]}`))
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		mapBaseUrl: server.URL + "/",
+	}
+
+	encounters, err := GetAreaEncounters("encounters-test-area", testConfig)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(encounters) != 2 {
+		t.Fatalf("expected 2 encounters, got %d", len(encounters))
+	}
+
+	if encounters[0].Name != "tentacool" || encounters[0].MinLevel != 15 || encounters[0].MaxLevel != 35 {
+		t.Errorf("expected tentacool at levels 15-35, got %+v", encounters[0])
+	}
+
+	if encounters[1].MinLevel != 0 || encounters[1].MaxLevel != 0 {
+		t.Errorf("expected no level range for magikarp, got %+v", encounters[1])
+	}
+}
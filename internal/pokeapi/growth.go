@@ -0,0 +1,52 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// GrowthRate is an experience curve. Experience[i] is the total experience
+// needed to reach level i+1.
+type GrowthRate struct {
+	Name       string
+	Experience []int
+}
+
+type growthRateAPIResponse struct {
+	Name   string `json:"name"`
+	Levels []struct {
+		Level      int `json:"level"`
+		Experience int `json:"experience"`
+	} `json:"levels"`
+}
+
+func GetGrowthRate(name string, c *config) (*GrowthRate, error) {
+	body, err := fetch(c.growthBaseUrl + name)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("growth rate not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse growthRateAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	levels := apiResponse.Levels
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Level < levels[j].Level
+	})
+
+	experience := make([]int, 0, len(levels))
+	for _, level := range levels {
+		experience = append(experience, level.Experience)
+	}
+
+	return &GrowthRate{
+		Name:       apiResponse.Name,
+		Experience: experience,
+	}, nil
+}
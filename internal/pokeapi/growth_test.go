@@ -0,0 +1,72 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetGrowthRate(t *testing.T) {
+	// Create a test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/medium" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"medium","levels":[{"level":3,"experience":27},{"level":1,"experience":0},{"level":2,"experience":8}]}`))
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		growthBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	rate, err := GetGrowthRate("medium", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if rate.Name != "medium" {
+		t.Errorf("Expected name 'medium', got %s", rate.Name)
+	}
+
+	expected := []int{0, 8, 27}
+	if len(rate.Experience) != len(expected) {
+		t.Fatalf("Expected %d levels, got %d", len(expected), len(rate.Experience))
+	}
+	for i, xp := range expected {
+		if rate.Experience[i] != xp {
+			t.Errorf("Expected level %d to need %d experience, got %d", i+1, xp, rate.Experience[i])
+		}
+	}
+}
+
+func TestGetGrowthRate_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		growthBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	_, err := GetGrowthRate("sideways", testConfig)
+	if err == nil {
+		t.Fatal("Expected error for non-existent growth rate, got nil")
+	}
+
+	if err.Error() != "growth rate not found" {
+		t.Errorf("Expected 'growth rate not found', got %s", err.Error())
+	}
+}
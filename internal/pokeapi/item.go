@@ -0,0 +1,58 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+type Item struct {
+	Name      string
+	Category  string
+	Cost      int
+	Effect    string
+	SpriteURL string
+}
+
+type itemAPIResponse struct {
+	Name          string           `json:"name"`
+	Cost          int              `json:"cost"`
+	Category      namedResourceAPI `json:"category"`
+	EffectEntries []struct {
+		ShortEffect string           `json:"short_effect"`
+		Language    namedResourceAPI `json:"language"`
+	} `json:"effect_entries"`
+	Sprites struct {
+		Default string `json:"default"`
+	} `json:"sprites"`
+}
+
+func GetItem(itemName string, c *config) (*Item, error) {
+	body, err := fetch(c.itemBaseUrl + itemName)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("item not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse itemAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	effect := ""
+	for _, entry := range apiResponse.EffectEntries {
+		if entry.Language.Name == "en" {
+			effect = entry.ShortEffect
+			break
+		}
+	}
+
+	return &Item{
+		Name:      apiResponse.Name,
+		Category:  apiResponse.Category.Name,
+		Cost:      apiResponse.Cost,
+		Effect:    effect,
+		SpriteURL: apiResponse.Sprites.Default,
+	}, nil
+}
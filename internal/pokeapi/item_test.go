@@ -0,0 +1,79 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetItem(t *testing.T) {
+	// Create a test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"name": "great-ball",
+			"cost": 600,
+			"category": {"name": "standard-balls", "url": ""},
+			"effect_entries": [
+				{"short_effect": "Ein Ball.", "language": {"name": "de", "url": ""}},
+				{"short_effect": "Tries to catch a wild Pokemon, success rate is 1.5x.", "language": {"name": "en", "url": ""}}
+			],
+			"sprites": {"default": "https://example.com/great-ball.png"}
+		}`))
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		itemBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	item, err := GetItem("great-ball", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if item.Name != "great-ball" {
+		t.Errorf("Expected name 'great-ball', got %s", item.Name)
+	}
+
+	if item.Category != "standard-balls" {
+		t.Errorf("Expected category 'standard-balls', got %s", item.Category)
+	}
+
+	if item.Cost != 600 {
+		t.Errorf("Expected cost 600, got %d", item.Cost)
+	}
+
+	if item.Effect != "Tries to catch a wild Pokemon, success rate is 1.5x." {
+		t.Errorf("Expected english effect text, got %s", item.Effect)
+	}
+
+	if item.SpriteURL != "https://example.com/great-ball.png" {
+		t.Errorf("Expected sprite URL, got %s", item.SpriteURL)
+	}
+}
+
+func TestGetItem_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		itemBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	_, err := GetItem("not-an-item", testConfig)
+	if err == nil {
+		t.Fatal("Expected error for non-existent item, got nil")
+	}
+}
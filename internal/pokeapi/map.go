@@ -3,8 +3,7 @@ package pokeapi
 import (
 	"encoding/json"
 	"errors"
-	"io"
-	"net/http"
+	"fmt"
 )
 
 type LocationAreaResponse struct {
@@ -43,38 +42,25 @@ func MapsBackward(c *config) ([]string, error) {
 	return GetMaps(url, c)
 }
 
-func GetMaps(url string, c *config) ([]string, error) {
-	if data, ok := cache.Get(url); ok {
-		var response LocationAreaResponse
-		if err := json.Unmarshal(data, &response); err == nil {
-			c.mapNextUrl = response.Next
-			c.mapPrevUrl = response.Previous
-
-			var areaNames []string
-			for _, area := range response.Results {
-				areaNames = append(areaNames, area.Name)
-			}
-			return areaNames, nil
-		}
-	}
+// MapPageSize is how many areas PokeAPI lists per page.
+const MapPageSize = 20
 
-	res, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+// MapsAt lists the page of areas starting at offset, leaving the paging
+// that MapsForward and MapsBackward follow alone.
+func MapsAt(offset int, c *config) ([]string, error) {
+	page := *c
+	return GetMaps(fmt.Sprintf("%s?offset=%d&limit=%d", c.mapBaseUrl, offset, MapPageSize), &page)
+}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, errors.New("failed to fetch data from PokeAPI")
+func GetMaps(url string, c *config) ([]string, error) {
+	body, err := fetch(url)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrUnavailable
 	}
-
-	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	cache.Add(url, body)
-
 	var response LocationAreaResponse
 	if err := json.Unmarshal(body, &response); err != nil {
 		return nil, err
@@ -340,3 +340,29 @@ func TestGetMaps(t *testing.T) {
 		}
 	})
 }
+
+func TestMapsAt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "40" || r.URL.Query().Get("limit") != "20" {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(LocationAreaResponse{
+			Next:    "next-page",
+			Results: []LocationArea{{Name: "mt-coronet-1f-route-207"}},
+		})
+	}))
+	defer server.Close()
+
+	c := &config{mapBaseUrl: server.URL + "/", mapNextUrl: "repl-page"}
+	areas, err := MapsAt(40, c)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(areas) != 1 || areas[0] != "mt-coronet-1f-route-207" {
+		t.Errorf("unexpected areas %v", areas)
+	}
+
+	if c.mapNextUrl != "repl-page" {
+		t.Errorf("expected the REPL's paging to be left alone, got %q", c.mapNextUrl)
+	}
+}
@@ -0,0 +1,59 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+type Move struct {
+	Name          string
+	Type          string
+	Power         int
+	Accuracy      int
+	PP            int
+	Priority      int
+	DamageClass   string
+	Ailment       string
+	AilmentChance int
+}
+
+type moveAPIResponse struct {
+	Name        string           `json:"name"`
+	Power       int              `json:"power"`
+	Accuracy    int              `json:"accuracy"`
+	PP          int              `json:"pp"`
+	Priority    int              `json:"priority"`
+	Type        namedResourceAPI `json:"type"`
+	DamageClass namedResourceAPI `json:"damage_class"`
+	Meta        struct {
+		Ailment       namedResourceAPI `json:"ailment"`
+		AilmentChance int              `json:"ailment_chance"`
+	} `json:"meta"`
+}
+
+func GetMove(moveName string, c *config) (*Move, error) {
+	body, err := fetch(c.moveBaseUrl + moveName)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("move not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse moveAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	return &Move{
+		Name:          apiResponse.Name,
+		Type:          apiResponse.Type.Name,
+		Power:         apiResponse.Power,
+		Accuracy:      apiResponse.Accuracy,
+		PP:            apiResponse.PP,
+		Priority:      apiResponse.Priority,
+		DamageClass:   apiResponse.DamageClass.Name,
+		Ailment:       apiResponse.Meta.Ailment.Name,
+		AilmentChance: apiResponse.Meta.AilmentChance,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetMove(t *testing.T) {
+	// Create a test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"name": "thunder-wave",
+			"power": null,
+			"accuracy": 90,
+			"pp": 20,
+			"priority": 0,
+			"type": {"name": "electric", "url": ""},
+			"damage_class": {"name": "status", "url": ""},
+			"meta": {"ailment": {"name": "paralysis", "url": ""}, "ailment_chance": 0}
+		}`))
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		moveBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	move, err := GetMove("thunder-wave", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if move.Power != 0 {
+		t.Errorf("Expected null power to decode as 0, got %d", move.Power)
+	}
+
+	if move.Accuracy != 90 || move.PP != 20 {
+		t.Errorf("Expected accuracy 90 and pp 20, got %d and %d", move.Accuracy, move.PP)
+	}
+
+	if move.Type != "electric" || move.DamageClass != "status" {
+		t.Errorf("Expected electric status move, got %s %s", move.Type, move.DamageClass)
+	}
+
+	if move.Ailment != "paralysis" {
+		t.Errorf("Expected paralysis ailment, got %s", move.Ailment)
+	}
+}
+
+func TestGetMove_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		moveBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	_, err := GetMove("not-a-move", testConfig)
+	if err == nil {
+		t.Fatal("Expected error for non-existent move, got nil")
+	}
+}
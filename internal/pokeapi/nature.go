@@ -0,0 +1,54 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Nature raises one stat by 10% and lowers another by 10%. Neutral natures
+// leave IncreasedStat and DecreasedStat empty.
+type Nature struct {
+	Name          string
+	IncreasedStat string
+	DecreasedStat string
+	LikesFlavor   string
+	HatesFlavor   string
+}
+
+type natureAPIResponse struct {
+	Name          string            `json:"name"`
+	IncreasedStat *namedResourceAPI `json:"increased_stat"`
+	DecreasedStat *namedResourceAPI `json:"decreased_stat"`
+	LikesFlavor   *namedResourceAPI `json:"likes_flavor"`
+	HatesFlavor   *namedResourceAPI `json:"hates_flavor"`
+}
+
+func GetNature(name string, c *config) (*Nature, error) {
+	body, err := fetch(c.natureBaseUrl + name)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("nature not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse natureAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	return &Nature{
+		Name:          apiResponse.Name,
+		IncreasedStat: resourceName(apiResponse.IncreasedStat),
+		DecreasedStat: resourceName(apiResponse.DecreasedStat),
+		LikesFlavor:   resourceName(apiResponse.LikesFlavor),
+		HatesFlavor:   resourceName(apiResponse.HatesFlavor),
+	}, nil
+}
+
+func resourceName(r *namedResourceAPI) string {
+	if r == nil {
+		return ""
+	}
+	return r.Name
+}
@@ -0,0 +1,59 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetNature(t *testing.T) {
+	// Create a test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/adamant":
+			w.Write([]byte(`{"name":"adamant","increased_stat":{"name":"attack","url":""},"decreased_stat":{"name":"special-attack","url":""},"likes_flavor":{"name":"spicy","url":""},"hates_flavor":{"name":"dry","url":""}}`))
+		case "/hardy":
+			w.Write([]byte(`{"name":"hardy","increased_stat":null,"decreased_stat":null,"likes_flavor":null,"hates_flavor":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		natureBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	nature, err := GetNature("adamant", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if nature.IncreasedStat != "attack" || nature.DecreasedStat != "special-attack" {
+		t.Errorf("Expected +attack -special-attack, got +%s -%s", nature.IncreasedStat, nature.DecreasedStat)
+	}
+
+	if nature.LikesFlavor != "spicy" {
+		t.Errorf("Expected adamant to like spicy, got %s", nature.LikesFlavor)
+	}
+
+	neutral, err := GetNature("hardy", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if neutral.IncreasedStat != "" || neutral.DecreasedStat != "" {
+		t.Errorf("Expected a neutral nature, got +%s -%s", neutral.IncreasedStat, neutral.DecreasedStat)
+	}
+
+	_, err = GetNature("grumpy", testConfig)
+	if err == nil || err.Error() != "nature not found" {
+		t.Errorf("Expected 'nature not found', got %v", err)
+	}
+}
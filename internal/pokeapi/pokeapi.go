@@ -1,9 +1,145 @@
 package pokeapi
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Nachsus/pokedexcli/internal/pokecache"
+	"github.com/Nachsus/pokedexcli/internal/snapshot"
 )
 
 var cache = pokecache.NewCache(5 * time.Minute)
+
+var ErrNotFound = errors.New("resource not found in PokeAPI")
+
+// ErrUnavailable means PokeAPI answered with something other than the data.
+var ErrUnavailable = errors.New("failed to fetch data from PokeAPI")
+
+// notFoundError names what wasn't found while still matching ErrNotFound.
+type notFoundError string
+
+func (e notFoundError) Error() string {
+	return string(e)
+}
+
+func (e notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ErrNotInSnapshot means a lookup offline needed something data sync didn't
+// download.
+var ErrNotInSnapshot = errors.New("not in the offline snapshot")
+
+type missingError string
+
+func (e missingError) Error() string {
+	return fmt.Sprintf("%s is not in the offline snapshot, run data sync while online to download it", string(e))
+}
+
+func (e missingError) Is(target error) bool {
+	return target == ErrNotInSnapshot
+}
+
+// numberError is a lookup by number offline, which a snapshot can't answer
+// since it keeps resources under their names.
+type numberError string
+
+func (e numberError) Error() string {
+	return fmt.Sprintf("%s is looked up by number, the offline snapshot only knows names", string(e))
+}
+
+func (e numberError) Is(target error) bool {
+	return target == ErrNotInSnapshot
+}
+
+// offline is the snapshot lookups read from instead of the network.
+var offline *snapshot.Snapshot
+
+// UseSnapshot makes every lookup read only from s, or from the network again
+// when s is nil.
+func UseSnapshot(s *snapshot.Snapshot) {
+	offline = s
+}
+
+func Offline() bool {
+	return offline != nil
+}
+
+// fromSnapshot reads a resource offline. A resource missing from a kind that
+// was synced in full doesn't exist, anything else just wasn't downloaded.
+// Resources are synced by name, so a number may well exist under its name.
+func fromSnapshot(url string) ([]byte, error) {
+	key := snapshotKey(url)
+	if data, ok := offline.Get(key); ok {
+		return data, nil
+	}
+
+	kind, name, _ := strings.Cut(key, "/")
+	if _, err := strconv.Atoi(name); err == nil {
+		return nil, numberError(key)
+	}
+	if offline.Synced(kind) {
+		return nil, ErrNotFound
+	}
+	return nil, missingError(key)
+}
+
+// snapshotKey is where the response for a URL lives in a snapshot: its path
+// below the API root and any query, so a snapshot works whatever host it was
+// downloaded from.
+func snapshotKey(rawURL string) string {
+	u, err := neturl.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(u.Path, "/api/v2"), "/")
+	if u.RawQuery != "" {
+		key += "?" + u.RawQuery
+	}
+	return key
+}
+
+// fetch gets a resource, from the cache when it was fetched recently and
+// otherwise from PokeAPI, or only from the snapshot when offline.
+func fetch(url string) ([]byte, error) {
+	if data, ok := cache.Get(url); ok {
+		return data, nil
+	}
+
+	var body []byte
+	var err error
+	if offline != nil {
+		body, err = fromSnapshot(url)
+	} else {
+		body, err = download(url)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cache.Add(url, body)
+	return body, nil
+}
+
+func download(url string) ([]byte, error) {
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, ErrUnavailable
+	}
+
+	return io.ReadAll(res.Body)
+}
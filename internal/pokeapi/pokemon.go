@@ -3,9 +3,7 @@ package pokeapi
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
-	"io"
-	"net/http"
+	"sort"
 )
 
 type PokemonDetails struct {
@@ -13,17 +11,49 @@ type PokemonDetails struct {
 	BaseExperience int            `json:"base_experience"`
 	Height         int            `json:"height"`
 	Weight         int            `json:"weight"`
+	Species        string         `json:"-"`
 	Stats          map[string]int `json:"-"`
 	Types          []string       `json:"-"`
+	Moves          []LearnedMove  `json:"-"`
+	Abilities      []string       `json:"-"`
+	Sprite         string         `json:"-"`
+	ShinySprite    string         `json:"-"`
+}
+
+// SpeciesName is the species this Pokemon or form belongs to.
+func (p PokemonDetails) SpeciesName() string {
+	if p.Species != "" {
+		return p.Species
+	}
+	return p.Name
+}
+
+type LearnedMove struct {
+	Name  string
+	Level int
 }
 
 type pokemonAPIResponse struct {
-	Name           string           `json:"name"`
-	BaseExperience int              `json:"base_experience"`
-	Height         int              `json:"height"`
-	Weight         int              `json:"weight"`
-	Stats          []pokemonStatAPI `json:"stats"`
-	Types          []pokemonTypeAPI `json:"types"`
+	Name           string              `json:"name"`
+	BaseExperience int                 `json:"base_experience"`
+	Height         int                 `json:"height"`
+	Weight         int                 `json:"weight"`
+	Species        namedResourceAPI    `json:"species"`
+	Stats          []pokemonStatAPI    `json:"stats"`
+	Types          []pokemonTypeAPI    `json:"types"`
+	Moves          []pokemonMoveAPI    `json:"moves"`
+	Abilities      []pokemonAbilityAPI `json:"abilities"`
+	Sprites        pokemonSpritesAPI   `json:"sprites"`
+}
+
+type pokemonSpritesAPI struct {
+	FrontDefault string `json:"front_default"`
+	FrontShiny   string `json:"front_shiny"`
+}
+
+type namedResourceAPI struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
 }
 
 type pokemonStatAPI struct {
@@ -39,37 +69,27 @@ type pokemonTypeAPI struct {
 	} `json:"type"`
 }
 
-func GetPokemon(pokemonName string, c *config) (*PokemonDetails, error) {
-	url := c.pokemonBaseUrl + pokemonName
-
-	if data, ok := cache.Get(url); ok {
-		var apiResponse pokemonAPIResponse
-		if err := json.Unmarshal(data, &apiResponse); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal cached data: %w", err)
-		}
-		return convertToPokemonDetails(apiResponse), nil
-	}
+type pokemonMoveAPI struct {
+	Move                namedResourceAPI `json:"move"`
+	VersionGroupDetails []struct {
+		LevelLearnedAt  int              `json:"level_learned_at"`
+		MoveLearnMethod namedResourceAPI `json:"move_learn_method"`
+	} `json:"version_group_details"`
+}
 
-	res, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+type pokemonAbilityAPI struct {
+	Ability namedResourceAPI `json:"ability"`
+}
 
-	if res.StatusCode == 404 {
-		return nil, errors.New("pokemon not found")
-	}
-	if res.StatusCode != http.StatusOK {
-		return nil, errors.New("failed to fetch data from PokeAPI")
+func GetPokemon(pokemonName string, c *config) (*PokemonDetails, error) {
+	body, err := fetch(c.pokemonBaseUrl + pokemonName)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("pokemon not found")
 	}
-
-	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	cache.Add(url, body)
-
 	var apiResponse pokemonAPIResponse
 	if err := json.Unmarshal(body, &apiResponse); err != nil {
 		return nil, err
@@ -91,12 +111,39 @@ func convertToPokemonDetails(apiResponse pokemonAPIResponse) *PokemonDetails {
 		types[i] = t.Type.Name
 	}
 
+	// Keep level-up moves, using the most recent version group's level
+	var moves []LearnedMove
+	for _, m := range apiResponse.Moves {
+		level := -1
+		for _, detail := range m.VersionGroupDetails {
+			if detail.MoveLearnMethod.Name == "level-up" {
+				level = detail.LevelLearnedAt
+			}
+		}
+		if level >= 0 {
+			moves = append(moves, LearnedMove{Name: m.Move.Name, Level: level})
+		}
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].Level < moves[j].Level
+	})
+
+	abilities := make([]string, len(apiResponse.Abilities))
+	for i, a := range apiResponse.Abilities {
+		abilities[i] = a.Ability.Name
+	}
+
 	return &PokemonDetails{
 		Name:           apiResponse.Name,
 		BaseExperience: apiResponse.BaseExperience,
 		Height:         apiResponse.Height,
 		Weight:         apiResponse.Weight,
+		Species:        apiResponse.Species.Name,
 		Stats:          stats,
 		Types:          types,
+		Moves:          moves,
+		Abilities:      abilities,
+		Sprite:         apiResponse.Sprites.FrontDefault,
+		ShinySprite:    apiResponse.Sprites.FrontShiny,
 	}
 }
@@ -209,3 +209,70 @@ func TestGetPokemon_DifferentPokemon(t *testing.T) {
 		t.Error("Expected different Pokemon to have different base experience")
 	}
 }
+
+func TestGetPokemon_Learnset(t *testing.T) {
+	testConfig := &config{
+		pokemonBaseUrl: "",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"name": "pikachu",
+			"species": {"name": "pikachu", "url": ""},
+			"sprites": {"front_default": "https://sprites/25.png", "front_shiny": "https://sprites/shiny/25.png", "back_default": "https://sprites/back/25.png"},
+			"abilities": [
+				{"ability": {"name": "static"}, "is_hidden": false},
+				{"ability": {"name": "lightning-rod"}, "is_hidden": true}
+			],
+			"moves": [
+				{"move": {"name": "thunderbolt"}, "version_group_details": [
+					{"level_learned_at": 0, "move_learn_method": {"name": "machine"}}
+				]},
+				{"move": {"name": "thunder-shock"}, "version_group_details": [
+					{"level_learned_at": 1, "move_learn_method": {"name": "level-up"}}
+				]},
+				{"move": {"name": "quick-attack"}, "version_group_details": [
+					{"level_learned_at": 16, "move_learn_method": {"name": "level-up"}},
+					{"level_learned_at": 6, "move_learn_method": {"name": "level-up"}}
+				]}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	testConfig.pokemonBaseUrl = server.URL + "/"
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	pokemon, err := GetPokemon("pikachu", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if pokemon.Species != "pikachu" {
+		t.Errorf("Expected species 'pikachu', got %s", pokemon.Species)
+	}
+
+	if pokemon.Sprite != "https://sprites/25.png" || pokemon.ShinySprite != "https://sprites/shiny/25.png" {
+		t.Errorf("Expected front sprites, got %q and %q", pokemon.Sprite, pokemon.ShinySprite)
+	}
+
+	if len(pokemon.Abilities) != 2 || pokemon.Abilities[1] != "lightning-rod" {
+		t.Errorf("Expected abilities [static lightning-rod], got %v", pokemon.Abilities)
+	}
+
+	// Machine moves are skipped and the latest version group wins
+	if len(pokemon.Moves) != 2 {
+		t.Fatalf("Expected 2 level-up moves, got %d", len(pokemon.Moves))
+	}
+
+	if pokemon.Moves[0].Name != "thunder-shock" || pokemon.Moves[0].Level != 1 {
+		t.Errorf("Expected thunder-shock at level 1 first, got %+v", pokemon.Moves[0])
+	}
+
+	if pokemon.Moves[1].Name != "quick-attack" || pokemon.Moves[1].Level != 6 {
+		t.Errorf("Expected quick-attack at level 6, got %+v", pokemon.Moves[1])
+	}
+}
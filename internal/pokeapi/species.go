@@ -0,0 +1,79 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+type PokemonSpecies struct {
+	Name        string
+	CaptureRate int
+	GenderRate  int
+	IsLegendary bool
+	IsMythical  bool
+	GrowthRate  string
+	Region      string
+}
+
+type Generation struct {
+	Name   string
+	Region string
+}
+
+// Generations lists every generation in order with the region it introduced,
+// which is where a species is considered to come from.
+var Generations = []Generation{
+	{"generation-i", "kanto"},
+	{"generation-ii", "johto"},
+	{"generation-iii", "hoenn"},
+	{"generation-iv", "sinnoh"},
+	{"generation-v", "unova"},
+	{"generation-vi", "kalos"},
+	{"generation-vii", "alola"},
+	{"generation-viii", "galar"},
+	{"generation-ix", "paldea"},
+}
+
+func regionOf(generation string) string {
+	for _, g := range Generations {
+		if g.Name == generation {
+			return g.Region
+		}
+	}
+	return ""
+}
+
+type speciesAPIResponse struct {
+	Name        string           `json:"name"`
+	CaptureRate int              `json:"capture_rate"`
+	GenderRate  int              `json:"gender_rate"`
+	IsLegendary bool             `json:"is_legendary"`
+	IsMythical  bool             `json:"is_mythical"`
+	GrowthRate  namedResourceAPI `json:"growth_rate"`
+	Generation  namedResourceAPI `json:"generation"`
+}
+
+func GetPokemonSpecies(speciesName string, c *config) (*PokemonSpecies, error) {
+	body, err := fetch(c.speciesBaseUrl + speciesName)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("pokemon species not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse speciesAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	return &PokemonSpecies{
+		Name:        apiResponse.Name,
+		CaptureRate: apiResponse.CaptureRate,
+		GenderRate:  apiResponse.GenderRate,
+		IsLegendary: apiResponse.IsLegendary,
+		IsMythical:  apiResponse.IsMythical,
+		GrowthRate:  apiResponse.GrowthRate.Name,
+		Region:      regionOf(apiResponse.Generation.Name),
+	}, nil
+}
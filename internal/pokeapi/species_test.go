@@ -0,0 +1,106 @@
+package pokeapi
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetPokemonSpecies(t *testing.T) {
+	// Create a test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mewtwo" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"mewtwo","capture_rate":3,"gender_rate":-1,"is_legendary":true,"is_mythical":false,"growth_rate":{"name":"slow","url":""},"generation":{"name":"generation-i","url":""}}`))
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		speciesBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	species, err := GetPokemonSpecies("mewtwo", testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if species.Name != "mewtwo" {
+		t.Errorf("Expected name 'mewtwo', got %s", species.Name)
+	}
+
+	if species.CaptureRate != 3 {
+		t.Errorf("Expected capture rate 3, got %d", species.CaptureRate)
+	}
+
+	if species.GenderRate != -1 {
+		t.Errorf("Expected genderless rate -1, got %d", species.GenderRate)
+	}
+
+	if !species.IsLegendary {
+		t.Error("Expected mewtwo to be legendary")
+	}
+
+	if species.GrowthRate != "slow" {
+		t.Errorf("Expected growth rate 'slow', got %s", species.GrowthRate)
+	}
+
+	if species.Region != "kanto" {
+		t.Errorf("Expected region 'kanto', got %s", species.Region)
+	}
+}
+
+func TestGetPokemonSpecies_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		speciesBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	_, err := GetPokemonSpecies("missingno", testConfig)
+	if err == nil {
+		t.Fatal("Expected error for non-existent species, got nil")
+	}
+
+	if err.Error() != "pokemon species not found" {
+		t.Errorf("Expected 'pokemon species not found', got %s", err.Error())
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected the error to match ErrNotFound")
+	}
+}
+
+func TestGetPokemonSpecies_InvalidJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("invalid json"))
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		speciesBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	_, err := GetPokemonSpecies("pikachu", testConfig)
+	if err == nil {
+		t.Fatal("Expected error for invalid JSON, got nil")
+	}
+}
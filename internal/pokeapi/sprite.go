@@ -0,0 +1,16 @@
+package pokeapi
+
+import "errors"
+
+// GetSprite downloads the image at a sprite URL from a PokemonDetails.
+func GetSprite(url string) ([]byte, error) {
+	if url == "" {
+		return nil, errors.New("no sprite available")
+	}
+
+	body, err := fetch(url)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("sprite not found")
+	}
+	return body, err
+}
@@ -0,0 +1,48 @@
+package pokeapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetSprite(t *testing.T) {
+	png := []byte("\x89PNG fake image data")
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/25.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(png)
+	}))
+	defer server.Close()
+
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	for i := 0; i < 2; i++ {
+		data, err := GetSprite(server.URL + "/25.png")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !bytes.Equal(data, png) {
+			t.Errorf("Expected the image bytes, got %q", data)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the second request to hit the cache, got %d requests", requests)
+	}
+
+	if _, err := GetSprite(server.URL + "/missing.png"); err == nil || err.Error() != "sprite not found" {
+		t.Errorf("Expected 'sprite not found', got %v", err)
+	}
+
+	if _, err := GetSprite(""); err == nil {
+		t.Error("Expected an error for a pokemon without a sprite")
+	}
+}
@@ -0,0 +1,105 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/Nachsus/pokedexcli/internal/snapshot"
+)
+
+// SyncKinds are the resources a snapshot can hold. DefaultSyncKinds are the
+// ones synced when none are chosen, enough to explore and catch offline.
+var (
+	SyncKinds        = []string{"location-area", "pokemon", "pokemon-species", "type", "growth-rate", "nature", "item", "move", "pokedex"}
+	DefaultSyncKinds = []string{"location-area", "pokemon", "pokemon-species", "type", "growth-rate", "nature"}
+)
+
+const syncWorkers = 8
+
+type resourceList struct {
+	Count   int                `json:"count"`
+	Results []namedResourceAPI `json:"results"`
+}
+
+// Sync downloads every resource of kind into snap, calling progress after
+// each one. Location areas also get the listing pages map walks through, and
+// types the listing GetTypes reads.
+// Sync always goes to the network, even when offline.
+func Sync(snap *snapshot.Snapshot, kind string, c *config, progress func(done, total int)) error {
+	base, ok := c.baseURL(kind)
+	if !ok {
+		return fmt.Errorf("unknown resource %s", kind)
+	}
+
+	body, err := download(fmt.Sprintf("%s?offset=0&limit=100000", base))
+	if err != nil {
+		return fmt.Errorf("listing %s: %w", kind, err)
+	}
+	var list resourceList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return fmt.Errorf("listing %s: %w", kind, err)
+	}
+
+	urls := make([]string, 0, len(list.Results))
+	for _, resource := range list.Results {
+		urls = append(urls, base+resource.Name)
+	}
+	if kind == "type" {
+		urls = append(urls, typeListURL(c))
+	}
+	if kind == "location-area" {
+		urls = append(urls, base)
+		for offset := 0; offset < len(list.Results); offset += MapPageSize {
+			urls = append(urls, fmt.Sprintf("%s?offset=%d&limit=%d", base, offset, MapPageSize))
+		}
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		done  int
+		first error
+	)
+	jobs := make(chan string)
+	for range syncWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for url := range jobs {
+				mu.Lock()
+				failed := first != nil
+				mu.Unlock()
+				if failed {
+					continue
+				}
+
+				data, err := download(url)
+				if err == nil {
+					err = snap.Put(snapshotKey(url), data)
+				}
+
+				mu.Lock()
+				if err != nil && first == nil {
+					first = fmt.Errorf("downloading %s: %w", snapshotKey(url), err)
+				}
+				done++
+				if progress != nil && first == nil {
+					progress(done, len(urls))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, url := range urls {
+		jobs <- url
+	}
+	close(jobs)
+	wg.Wait()
+
+	if first != nil {
+		return first
+	}
+	snap.MarkSynced(kind)
+	return nil
+}
@@ -0,0 +1,158 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+	"github.com/Nachsus/pokedexcli/internal/snapshot"
+)
+
+// fakePokeAPI serves a tiny PokeAPI with a few pokemon and 25 areas, so the
+// areas span two listing pages.
+func fakePokeAPI(t *testing.T) *httptest.Server {
+	pokemon := []string{"pikachu", "bulbasaur", "charmander"}
+	var areas []string
+	for i := 1; i <= 25; i++ {
+		areas = append(areas, fmt.Sprintf("route-%d", i))
+	}
+
+	list := func(w http.ResponseWriter, r *http.Request, names []string) {
+		offset, limit := 0, 20
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		fmt.Sscan(r.URL.Query().Get("limit"), &limit)
+		response := LocationAreaResponse{Count: len(names)}
+		for i := offset; i < len(names) && i < offset+limit; i++ {
+			response.Results = append(response.Results, LocationArea{Name: names[i]})
+		}
+		if offset+limit < len(names) {
+			response.Next = fmt.Sprintf("https://pokeapi.co/api/v2/location-area/?offset=%d&limit=%d", offset+limit, limit)
+		}
+		json.NewEncoder(w).Encode(response)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kind, name, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/v2/"), "/")
+		switch {
+		case kind == "pokemon" && name == "":
+			list(w, r, pokemon)
+		case kind == "pokemon":
+			fmt.Fprintf(w, `{"name":%q,"base_experience":64,"height":4,"weight":60}`, name)
+		case kind == "location-area" && name == "":
+			list(w, r, areas)
+		case kind == "location-area":
+			fmt.Fprint(w, `{"pokemon_encounters":[{"pokemon":{"name":"pikachu"}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func testSyncConfig(url string) *config {
+	return &config{
+		mapBaseUrl:     url + "/api/v2/location-area/",
+		pokemonBaseUrl: url + "/api/v2/pokemon/",
+		speciesBaseUrl: url + "/api/v2/pokemon-species/",
+	}
+}
+
+func TestSync(t *testing.T) {
+	server := fakePokeAPI(t)
+	defer server.Close()
+	c := testSyncConfig(server.URL)
+
+	snap := snapshot.New(time.Now())
+	calls := 0
+	if err := Sync(snap, "pokemon", c, func(done, total int) {
+		calls++
+		if total != 3 {
+			t.Errorf("expected 3 pokemon in total, got %d", total)
+		}
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected progress after each pokemon, got %d calls", calls)
+	}
+	if err := Sync(snap, "location-area", c, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// 3 pokemon, then 25 areas, the bare first page and 2 listing pages
+	if snap.Len() != 3+25+1+2 {
+		t.Errorf("expected 31 resources, got %d: %v", snap.Len(), snap.Keys())
+	}
+	if _, ok := snap.Get("location-area/?offset=20&limit=20"); !ok {
+		t.Errorf("expected the second listing page, got %v", snap.Keys())
+	}
+	if !snap.Synced("pokemon") || snap.Synced("pokemon-species") {
+		t.Errorf("expected only the synced kinds to be marked, got %v", snap.SyncedKinds())
+	}
+
+	if err := Sync(snap, "pokemon-species", c, nil); err == nil {
+		t.Error("expected an error when PokeAPI can't list a kind")
+	}
+	if err := Sync(snap, "berries", c, nil); err == nil {
+		t.Error("expected an error for an unknown kind")
+	}
+}
+
+func TestOffline(t *testing.T) {
+	server := fakePokeAPI(t)
+	c := testSyncConfig(server.URL)
+	snap := snapshot.New(time.Now())
+	for _, kind := range []string{"pokemon", "location-area"} {
+		if err := Sync(snap, kind, c, nil); err != nil {
+			t.Fatalf("expected no error syncing %s, got %v", kind, err)
+		}
+	}
+
+	// Nothing may reach the network from here on
+	server.Close()
+	cache = pokecache.NewCache(5 * time.Minute)
+	UseSnapshot(snap)
+	defer UseSnapshot(nil)
+
+	pokemon, err := GetPokemon("pikachu", c)
+	if err != nil || pokemon.Name != "pikachu" {
+		t.Fatalf("expected pikachu from the snapshot, got %v, %v", pokemon, err)
+	}
+
+	areas, err := MapsForward(c)
+	if err != nil || len(areas) != 20 {
+		t.Fatalf("expected the first page of areas, got %v, %v", areas, err)
+	}
+	if areas, err = MapsForward(c); err != nil || len(areas) != 5 || areas[0] != "route-21" {
+		t.Errorf("expected to page through the snapshot, got %v, %v", areas, err)
+	}
+
+	names, err := GetPokemonFromArea("route-3", c)
+	if err != nil || len(names) != 1 || names[0] != "pikachu" {
+		t.Errorf("expected route-3's pokemon, got %v, %v", names, err)
+	}
+
+	// Missing from a kind that was synced means it doesn't exist
+	if _, err := GetPokemon("missingno", c); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	// Numbers aren't names, so the snapshot can't tell whether they exist
+	if _, err := GetPokemon("25", c); !errors.Is(err, ErrNotInSnapshot) || errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a number to be unanswerable offline, got %v", err)
+	}
+
+	// Missing from a kind that wasn't synced says what to do
+	_, err = GetPokemonSpecies("pikachu", c)
+	if !errors.Is(err, ErrNotInSnapshot) {
+		t.Fatalf("expected the species to be missing, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "pokemon-species/pikachu") || !strings.Contains(err.Error(), "data sync") {
+		t.Errorf("expected the error to name what is missing, got %q", err)
+	}
+}
@@ -0,0 +1,70 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// typeListLimit is more than the number of types PokeAPI has, so one page
+// lists them all.
+const typeListLimit = 100
+
+type typeAPIResponse struct {
+	Name    string `json:"name"`
+	Pokemon []struct {
+		Pokemon namedResourceAPI `json:"pokemon"`
+	} `json:"pokemon"`
+}
+
+// GetType returns the names of the Pokemon that have type name.
+func GetType(name string, c *config) ([]string, error) {
+	body, err := fetch(c.typeBaseUrl + name)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError("type not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse typeAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, err
+	}
+
+	pokemon := make([]string, 0, len(apiResponse.Pokemon))
+	for _, p := range apiResponse.Pokemon {
+		pokemon = append(pokemon, p.Pokemon.Name)
+	}
+	return pokemon, nil
+}
+
+// GetTypes returns every type with the names of the Pokemon that have it.
+// Types no Pokemon has, such as unknown, are left out.
+func GetTypes(c *config) (map[string][]string, error) {
+	body, err := fetch(typeListURL(c))
+	if err != nil {
+		return nil, err
+	}
+
+	var list resourceList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+
+	types := make(map[string][]string, len(list.Results))
+	for _, resource := range list.Results {
+		pokemon, err := GetType(resource.Name, c)
+		if err != nil {
+			return nil, err
+		}
+		if len(pokemon) > 0 {
+			types[resource.Name] = pokemon
+		}
+	}
+	return types, nil
+}
+
+func typeListURL(c *config) string {
+	return fmt.Sprintf("%s?offset=0&limit=%d", c.typeBaseUrl, typeListLimit)
+}
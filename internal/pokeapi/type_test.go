@@ -0,0 +1,56 @@
+package pokeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokecache"
+)
+
+func TestGetTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			if r.URL.Query().Get("limit") == "" {
+				t.Errorf("expected the listing to ask for every type, got %s", r.URL)
+			}
+			w.Write([]byte(`{"count":3,"results":[{"name":"electric"},{"name":"water"},{"name":"unknown"}]}`))
+		case "/electric":
+			w.Write([]byte(`{"name":"electric","pokemon":[{"pokemon":{"name":"pikachu"}},{"pokemon":{"name":"magnemite"}}]}`))
+		case "/water":
+			w.Write([]byte(`{"name":"water","pokemon":[{"pokemon":{"name":"squirtle"}}]}`))
+		case "/unknown":
+			w.Write([]byte(`{"name":"unknown","pokemon":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testConfig := &config{
+		typeBaseUrl: server.URL + "/",
+	}
+
+	// Reset cache for test
+	cache = pokecache.NewCache(5 * time.Minute)
+
+	types, err := GetTypes(testConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string][]string{
+		"electric": {"pikachu", "magnemite"},
+		"water":    {"squirtle"},
+	}
+	if !reflect.DeepEqual(types, expected) {
+		t.Errorf("Expected %v, got %v", expected, types)
+	}
+
+	if _, err := GetType("shadow", testConfig); err == nil || err.Error() != "type not found" {
+		t.Errorf("Expected 'type not found', got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package pokedex
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/experience"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+const shinyOdds = 4096
+
+// Entry is one caught Pokemon. Two Pikachu are two entries with their own
+// ID, level and individual values.
+type Entry struct {
+	ID         int
+	Pokemon    pokeapi.PokemonDetails
+	Level      int
+	Experience int
+	GrowthRate string
+	IVs        map[string]int
+	EVs        map[string]int
+	Nature     string
+	Gender     string
+	Shiny      bool
+	Nickname   string
+	Tags       []string
+	CaughtAt   time.Time
+	Area       string
+	Region     string
+	Ball       string
+}
+
+// NewEntry rolls the individual traits of a Pokemon met in the wild.
+// genderRate is the species' chance of being female in eighths, or -1 for
+// genderless species.
+func NewEntry(pokemon pokeapi.PokemonDetails, genderRate, level int, rng *rand.Rand) Entry {
+	ivs := make(map[string]int, len(stats.Names))
+	evs := make(map[string]int, len(stats.Names))
+	for _, name := range stats.Names {
+		ivs[name] = rng.Intn(stats.MaxIV + 1)
+		evs[name] = 0
+	}
+
+	gender := "genderless"
+	if genderRate >= 0 {
+		gender = "male"
+		if rng.Intn(8) < genderRate {
+			gender = "female"
+		}
+	}
+
+	return Entry{
+		Pokemon:    pokemon,
+		Level:      level,
+		Experience: level * level * level,
+		IVs:        ivs,
+		EVs:        evs,
+		Nature:     stats.Natures[rng.Intn(len(stats.Natures))].Name,
+		Gender:     gender,
+		Shiny:      rng.Intn(shinyOdds) == 0,
+	}
+}
+
+func (e Entry) DisplayName() string {
+	if e.Nickname != "" {
+		return e.Nickname
+	}
+	return e.Pokemon.Name
+}
+
+func (e Entry) Stats() map[string]int {
+	return e.StatsAt(e.Level)
+}
+
+// StatsAt previews the Pokemon's stats at another level with its current
+// IVs, EVs and nature.
+func (e Entry) StatsAt(level int) map[string]int {
+	return stats.Calculate(e.Pokemon.Stats, e.IVs, e.EVs, level, e.Nature)
+}
+
+// GainExperience adds xp along curve and levels the Pokemon up to match. It
+// returns the number of levels gained.
+func (e *Entry) GainExperience(xp int, curve experience.Curve) int {
+	before := e.Level
+	e.Experience = max(e.Experience, curve.ForLevel(e.Level))
+	e.Experience = min(e.Experience+xp, curve.MaxExperience())
+	e.Level = max(e.Level, curve.Level(e.Experience))
+	return e.Level - before
+}
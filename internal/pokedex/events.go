@@ -0,0 +1,203 @@
+package pokedex
+
+import "fmt"
+
+// MaxLogSize bounds the event log, which only lives in memory since a save
+// holds the collection itself. Once the log has MaxLogSize more events than
+// there are Pokemon, it is compacted to one Restored event per Pokemon:
+// replaying it still rebuilds the collection, but older history is gone.
+const MaxLogSize = 1000
+
+// Event is one change to a Pokedex. Every mutation is recorded as an event,
+// so replaying the log rebuilds the collection.
+type Event interface {
+	EntryID() int
+}
+
+// Caught is a newly caught Pokemon.
+type Caught struct {
+	Entry Entry
+}
+
+// Restored is a Pokemon put back with the ID it already had, as read from a
+// save or when a release is undone.
+type Restored struct {
+	Entry Entry
+}
+
+type Released struct {
+	Entry Entry
+}
+
+// Renamed changed the nickname of Entry from From; an empty nickname means
+// none.
+type Renamed struct {
+	Entry Entry
+	From  string
+}
+
+// Retagged changed the tags of Entry from From.
+type Retagged struct {
+	Entry Entry
+	From  []string
+}
+
+// Leveled is a Pokemon that gained experience. After.Level is above
+// Before.Level when it grew a level.
+type Leveled struct {
+	Before Entry
+	After  Entry
+}
+
+type Evolved struct {
+	Before Entry
+	After  Entry
+}
+
+// Updated changed an entry without it leveling or evolving, such as filling
+// in data older saves lack.
+type Updated struct {
+	Before Entry
+	After  Entry
+}
+
+func (e Caught) EntryID() int   { return e.Entry.ID }
+func (e Restored) EntryID() int { return e.Entry.ID }
+func (e Released) EntryID() int { return e.Entry.ID }
+func (e Renamed) EntryID() int  { return e.Entry.ID }
+func (e Retagged) EntryID() int { return e.Entry.ID }
+func (e Leveled) EntryID() int  { return e.After.ID }
+func (e Evolved) EntryID() int  { return e.After.ID }
+func (e Updated) EntryID() int  { return e.After.ID }
+
+// apply changes the collection as event describes. The caller holds p.mu.
+func (p *Pokedex) apply(event Event) error {
+	switch e := event.(type) {
+	case Caught:
+		return p.insert(e.Entry)
+	case Restored:
+		return p.insert(e.Entry)
+	case Released:
+		if _, exists := p.pokemon[e.Entry.ID]; !exists {
+			return fmt.Errorf("no pokemon with ID %d", e.Entry.ID)
+		}
+		delete(p.pokemon, e.Entry.ID)
+	case Renamed:
+		return p.replace(e.Entry)
+	case Retagged:
+		return p.replace(e.Entry)
+	case Leveled:
+		return p.replace(e.After)
+	case Evolved:
+		return p.replace(e.After)
+	case Updated:
+		return p.replace(e.After)
+	default:
+		return fmt.Errorf("unknown event %T", event)
+	}
+	return nil
+}
+
+func (p *Pokedex) insert(entry Entry) error {
+	if entry.ID <= 0 {
+		return fmt.Errorf("invalid pokemon ID %d", entry.ID)
+	}
+	if _, exists := p.pokemon[entry.ID]; exists {
+		return fmt.Errorf("duplicate pokemon ID %d", entry.ID)
+	}
+
+	p.pokemon[entry.ID] = entry
+	if entry.ID >= p.nextID {
+		p.nextID = entry.ID + 1
+	}
+	return nil
+}
+
+func (p *Pokedex) replace(entry Entry) error {
+	if _, exists := p.pokemon[entry.ID]; !exists {
+		return fmt.Errorf("no pokemon with ID %d", entry.ID)
+	}
+	p.pokemon[entry.ID] = entry
+	return nil
+}
+
+// change builds events from the current collection and applies them in one
+// step, then tells subscribers once the lock is released so they may read the
+// Pokedex again. Changes are applied and published one at a time, so
+// subscribers see events in the order of the log.
+func (p *Pokedex) change(build func() ([]Event, error)) ([]Event, error) {
+	p.publishMu.Lock()
+	defer p.publishMu.Unlock()
+
+	p.mu.Lock()
+	events, err := build()
+	if err == nil {
+		for _, event := range events {
+			if err = p.apply(event); err != nil {
+				break
+			}
+			p.log = append(p.log, event)
+		}
+		p.compact()
+	}
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		p.bus.Publish(event)
+	}
+	return events, nil
+}
+
+// compact swaps a log that grew past MaxLogSize for the current collection.
+// The caller holds p.mu.
+func (p *Pokedex) compact() {
+	if len(p.log) < MaxLogSize+len(p.pokemon) {
+		return
+	}
+
+	var log []Event
+	// Keep the released Pokemon with the highest ID, so a replay hands out
+	// the same IDs from then on
+	if _, exists := p.pokemon[p.nextID-1]; !exists {
+		for i := len(p.log) - 1; i >= 0; i-- {
+			if e, ok := p.log[i].(Released); ok && e.Entry.ID == p.nextID-1 {
+				log = append(log, Restored{Entry: e.Entry}, e)
+				break
+			}
+		}
+	}
+	for _, entry := range p.sorted() {
+		log = append(log, Restored{Entry: entry})
+	}
+	p.log = log
+}
+
+// Subscribe calls handler with every event from now on and returns a
+// function that stops it. Handlers may read the Pokedex but not change it.
+func (p *Pokedex) Subscribe(handler func(Event)) func() {
+	return p.bus.Subscribe(handler)
+}
+
+// Events returns the log of changes so far, oldest first. Once compacted it
+// starts with Restored events standing in for the older history.
+func (p *Pokedex) Events() []Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Event(nil), p.log...)
+}
+
+// Replay builds a new Pokedex from an event log. Nobody is subscribed yet, so
+// nothing is told about the events again.
+func Replay(log []Event) (*Pokedex, error) {
+	p := NewPokedex()
+	for i, event := range log {
+		if err := p.apply(event); err != nil {
+			return nil, fmt.Errorf("event %d: %w", i+1, err)
+		}
+	}
+	p.log = append(p.log, log...)
+	return p, nil
+}
@@ -0,0 +1,165 @@
+package pokedex
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+func TestSubscribe(t *testing.T) {
+	dex := NewPokedex()
+
+	var got []Event
+	stop := dex.Subscribe(func(event Event) {
+		got = append(got, event)
+	})
+
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}, Level: 5})
+	dex.Rename(entry.ID, "sparky")
+
+	leveled := entry
+	leveled.Nickname = "sparky"
+	leveled.Level = 6
+	dex.Update(leveled)
+
+	dex.Update(leveled)
+	updated := leveled
+	updated.GrowthRate = "medium-fast"
+	dex.Update(updated)
+
+	evolved := updated
+	evolved.Pokemon.Name = "raichu"
+	dex.Update(evolved)
+
+	dex.Remove(entry.ID)
+	stop()
+	dex.Restore(evolved)
+
+	expected := []Event{
+		Caught{Entry: entry},
+		Renamed{Entry: Entry{ID: 1, Pokemon: entry.Pokemon, Level: 5, Nickname: "sparky"}, From: ""},
+		Leveled{Before: Entry{ID: 1, Pokemon: entry.Pokemon, Level: 5, Nickname: "sparky"}, After: leveled},
+		Updated{Before: leveled, After: updated},
+		Evolved{Before: updated, After: evolved},
+		Released{Entry: evolved},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected events\n%+v\ngot\n%+v", expected, got)
+	}
+}
+
+func TestFailedChangesAreNotPublished(t *testing.T) {
+	dex := NewPokedex()
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+
+	published := 0
+	dex.Subscribe(func(Event) { published++ })
+
+	dex.Remove(99)
+	dex.Rename(99, "ghost")
+	dex.Restore(entry)
+
+	renamed := entry
+	renamed.Nickname = "sparky"
+	if err := dex.Update(renamed); err == nil {
+		t.Error("expected Update to refuse a nickname change")
+	}
+
+	if published != 0 {
+		t.Errorf("expected no events, got %d", published)
+	}
+	if len(dex.Events()) != 1 {
+		t.Errorf("expected only the catch in the log, got %v", dex.Events())
+	}
+}
+
+func TestConcurrentChangesArePublishedInLogOrder(t *testing.T) {
+	dex := NewPokedex()
+
+	var got []Event
+	dex.Subscribe(func(event Event) {
+		got = append(got, event)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+			dex.Rename(entry.ID, "sparky")
+		}()
+	}
+	wg.Wait()
+
+	if !reflect.DeepEqual(got, dex.Events()) {
+		t.Error("expected subscribers to see events in the order of the log")
+	}
+}
+
+func TestSubscriberCanReadPokedex(t *testing.T) {
+	dex := NewPokedex()
+
+	count := 0
+	dex.Subscribe(func(Event) {
+		count = len(dex.GetAll())
+	})
+
+	dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+	if count != 1 {
+		t.Errorf("expected the subscriber to see 1 pokemon, got %d", count)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	dex := NewPokedex()
+	pikachu := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}, Level: 5})
+	eevee := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "eevee"}, Level: 3})
+	dex.Restore(Entry{ID: 7, Pokemon: pokeapi.PokemonDetails{Name: "zubat"}})
+	dex.Rename(pikachu.ID, "sparky")
+	dex.Remove(eevee.ID)
+
+	rebuilt, err := Replay(dex.Events())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(rebuilt.GetAll(), dex.GetAll()) {
+		t.Errorf("expected %+v, got %+v", dex.GetAll(), rebuilt.GetAll())
+	}
+	if next := rebuilt.Add(Entry{}); next.ID != 8 {
+		t.Errorf("expected the next ID to be 8, got %d", next.ID)
+	}
+
+	if _, err := Replay([]Event{Released{Entry: pikachu}}); err == nil {
+		t.Error("expected error releasing a pokemon that was never caught")
+	}
+}
+
+func TestLogIsCompacted(t *testing.T) {
+	dex := NewPokedex()
+	pikachu := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+	eevee := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "eevee"}})
+	dex.Rename(eevee.ID, "vee")
+	dex.Remove(eevee.ID)
+	for i := 0; i < MaxLogSize; i++ {
+		dex.Rename(pikachu.ID, fmt.Sprintf("sparky%d", i%10))
+	}
+
+	if n := len(dex.Events()); n >= MaxLogSize+1 {
+		t.Errorf("expected the log to be compacted, got %d events", n)
+	}
+
+	rebuilt, err := Replay(dex.Events())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(rebuilt.GetAll(), dex.GetAll()) {
+		t.Errorf("expected %+v, got %+v", dex.GetAll(), rebuilt.GetAll())
+	}
+	if next := rebuilt.Add(Entry{}); next.ID != 3 {
+		t.Errorf("expected the next ID to be 3, got %d", next.ID)
+	}
+}
@@ -0,0 +1,137 @@
+package pokedex
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+type Operation int
+
+const (
+	OpAdd Operation = iota
+	OpRemove
+	OpRename
+	OpRetag
+)
+
+// Mutation is one recorded change to a Pokedex. Entry is the Pokemon as it
+// was added or removed; a rename keeps the nicknames it changed between and a
+// retag the tags.
+type Mutation struct {
+	Op       Operation
+	Entry    Entry
+	From     string
+	To       string
+	FromTags []string
+	ToTags   []string
+}
+
+func (m Mutation) String() string {
+	name := fmt.Sprintf("%s (#%d)", m.Entry.Pokemon.Name, m.Entry.ID)
+	switch m.Op {
+	case OpAdd:
+		return "caught " + name
+	case OpRemove:
+		return "released " + name
+	case OpRename:
+		if m.To == "" {
+			return "cleared the nickname of " + name
+		}
+		return fmt.Sprintf("nicknamed %s %s", name, m.To)
+	case OpRetag:
+		return "changed the tags of " + name
+	}
+	return "changed " + name
+}
+
+// apply makes the change again, or reverts it when reverse is set.
+func (m Mutation) apply(p *Pokedex, reverse bool) error {
+	switch {
+	case m.Op == OpAdd && !reverse, m.Op == OpRemove && reverse:
+		return p.Restore(m.Entry)
+	case m.Op == OpAdd, m.Op == OpRemove:
+		_, err := p.Remove(m.Entry.ID)
+		return err
+	case m.Op == OpRename && reverse:
+		_, err := p.Rename(m.Entry.ID, m.From)
+		return err
+	case m.Op == OpRename:
+		_, err := p.Rename(m.Entry.ID, m.To)
+		return err
+	case m.Op == OpRetag && reverse:
+		_, err := p.SetTags(m.Entry.ID, m.FromTags)
+		return err
+	case m.Op == OpRetag:
+		_, err := p.SetTags(m.Entry.ID, m.ToTags)
+		return err
+	}
+	return fmt.Errorf("unknown operation %d", m.Op)
+}
+
+// Journal remembers the most recent mutations so they can be undone and
+// redone. Recording a new mutation forgets anything that was undone.
+type Journal struct {
+	mu     sync.Mutex
+	done   []Mutation
+	undone []Mutation
+	limit  int
+}
+
+func NewJournal(limit int) *Journal {
+	return &Journal{limit: limit}
+}
+
+func (j *Journal) Record(m Mutation) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.done = append(j.done, m)
+	if len(j.done) > j.limit {
+		j.done = j.done[len(j.done)-j.limit:]
+	}
+	j.undone = nil
+}
+
+// Undo reverts the latest mutation on p and returns it.
+func (j *Journal) Undo(p *Pokedex) (Mutation, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if len(j.done) == 0 {
+		return Mutation{}, errors.New("nothing to undo")
+	}
+	m := j.done[len(j.done)-1]
+	if err := m.apply(p, true); err != nil {
+		return Mutation{}, err
+	}
+	j.done = j.done[:len(j.done)-1]
+	j.undone = append(j.undone, m)
+	return m, nil
+}
+
+// Redo reapplies the latest undone mutation on p and returns it.
+func (j *Journal) Redo(p *Pokedex) (Mutation, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if len(j.undone) == 0 {
+		return Mutation{}, errors.New("nothing to redo")
+	}
+	m := j.undone[len(j.undone)-1]
+	if err := m.apply(p, false); err != nil {
+		return Mutation{}, err
+	}
+	j.undone = j.undone[:len(j.undone)-1]
+	j.done = append(j.done, m)
+	return m, nil
+}
+
+// Clear forgets every mutation, such as after the whole collection was
+// replaced.
+func (j *Journal) Clear() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.done = nil
+	j.undone = nil
+}
@@ -0,0 +1,125 @@
+package pokedex
+
+import (
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+func TestRemoveAndRename(t *testing.T) {
+	dex := NewPokedex()
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+
+	previous, err := dex.Rename(entry.ID, "sparky")
+	if err != nil || previous != "" {
+		t.Fatalf("expected no previous nickname, got %q, %v", previous, err)
+	}
+
+	if _, err := dex.Rename(entry.ID, "a-very-long-nickname"); err == nil {
+		t.Error("expected error for a nickname that is too long")
+	}
+	if _, err := dex.Rename(entry.ID, "bad\tname"); err == nil {
+		t.Error("expected error for a nickname with a control character")
+	}
+
+	removed, err := dex.Remove(entry.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if removed.Nickname != "sparky" {
+		t.Errorf("expected the removed entry to keep its nickname, got %q", removed.Nickname)
+	}
+
+	if _, err := dex.Remove(entry.ID); err == nil {
+		t.Error("expected error removing twice")
+	}
+	if _, err := dex.Rename(entry.ID, "x"); err == nil {
+		t.Error("expected error renaming a removed pokemon")
+	}
+}
+
+func TestJournalUndoRedo(t *testing.T) {
+	dex := NewPokedex()
+	journal := NewJournal(10)
+
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+	journal.Record(Mutation{Op: OpAdd, Entry: entry})
+
+	dex.Rename(entry.ID, "sparky")
+	journal.Record(Mutation{Op: OpRename, Entry: entry, From: "", To: "sparky"})
+
+	removed, _ := dex.Remove(entry.ID)
+	journal.Record(Mutation{Op: OpRemove, Entry: removed})
+
+	// Undo the release, then the nickname, then the catch
+	if m, err := journal.Undo(dex); err != nil || m.Op != OpRemove {
+		t.Fatalf("expected to undo the release, got %v, %v", m, err)
+	}
+	if got, ok := dex.Get(entry.ID); !ok || got.Nickname != "sparky" {
+		t.Errorf("expected sparky back, got %+v", got)
+	}
+
+	journal.Undo(dex)
+	if got, _ := dex.Get(entry.ID); got.Nickname != "" {
+		t.Errorf("expected the nickname to be cleared, got %q", got.Nickname)
+	}
+
+	journal.Undo(dex)
+	if _, ok := dex.Get(entry.ID); ok {
+		t.Error("expected the catch to be undone")
+	}
+
+	if _, err := journal.Undo(dex); err == nil {
+		t.Error("expected nothing left to undo")
+	}
+
+	// Redo everything again in order
+	for i := 0; i < 3; i++ {
+		if _, err := journal.Redo(dex); err != nil {
+			t.Fatalf("redo %d: expected no error, got %v", i, err)
+		}
+	}
+	if _, ok := dex.Get(entry.ID); ok {
+		t.Error("expected the release to be redone")
+	}
+
+	if _, err := journal.Redo(dex); err == nil {
+		t.Error("expected nothing left to redo")
+	}
+}
+
+func TestJournalRecordClearsRedo(t *testing.T) {
+	dex := NewPokedex()
+	journal := NewJournal(10)
+
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+	journal.Record(Mutation{Op: OpAdd, Entry: entry})
+	journal.Undo(dex)
+
+	other := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "eevee"}})
+	journal.Record(Mutation{Op: OpAdd, Entry: other})
+
+	if _, err := journal.Redo(dex); err == nil {
+		t.Error("expected a new mutation to drop the undone one")
+	}
+}
+
+func TestJournalIsBounded(t *testing.T) {
+	dex := NewPokedex()
+	journal := NewJournal(2)
+
+	for _, name := range []string{"pidgey", "rattata", "spearow"} {
+		entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: name}})
+		journal.Record(Mutation{Op: OpAdd, Entry: entry})
+	}
+
+	journal.Undo(dex)
+	journal.Undo(dex)
+	if _, err := journal.Undo(dex); err == nil {
+		t.Error("expected the oldest mutation to be forgotten")
+	}
+
+	if !dex.Has("pidgey") || dex.Has("rattata") || dex.Has("spearow") {
+		t.Errorf("expected only pidgey left, got %v", dex.GetAll())
+	}
+}
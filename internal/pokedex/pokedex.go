@@ -1,49 +1,232 @@
 package pokedex
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"unicode"
+	"unicode/utf8"
 
-	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/events"
 )
 
+const MaxNicknameLength = 12
+
+// ValidateNickname checks a nickname against the rules for every way a
+// Pokemon gets one: at most MaxNicknameLength characters, without spaces or
+// control characters. An empty nickname means none.
+func ValidateNickname(nickname string) error {
+	if utf8.RuneCountInString(nickname) > MaxNicknameLength {
+		return fmt.Errorf("nicknames can be at most %d characters", MaxNicknameLength)
+	}
+	for _, r := range nickname {
+		if unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return errors.New("nicknames can't contain spaces or control characters")
+		}
+	}
+	return nil
+}
+
 type Pokedex struct {
 	mu      sync.Mutex
-	pokemon map[string]pokeapi.PokemonDetails
+	pokemon map[int]Entry
+	nextID  int
+	log     []Event
+	bus     *events.Bus[Event]
+
+	// publishMu is held from applying a change until it's published
+	publishMu sync.Mutex
 }
 
 func NewPokedex() *Pokedex {
 	return &Pokedex{
-		pokemon: make(map[string]pokeapi.PokemonDetails),
+		pokemon: make(map[int]Entry),
+		nextID:  1,
+		bus:     events.NewBus[Event](),
 	}
 }
 
-func (p *Pokedex) Add(pokemon pokeapi.PokemonDetails) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-	p.pokemon[pokemon.Name] = pokemon
+// Add stores entry under a fresh ID and returns the stored copy.
+func (p *Pokedex) Add(entry Entry) Entry {
+	p.change(func() ([]Event, error) {
+		entry.ID = p.nextID
+		return []Event{Caught{Entry: entry}}, nil
+	})
+	return entry
+}
+
+// Restore puts back an entry that already has an ID, as read from a save.
+func (p *Pokedex) Restore(entry Entry) error {
+	_, err := p.change(func() ([]Event, error) {
+		return []Event{Restored{Entry: entry}}, nil
+	})
+	return err
 }
 
-func (p *Pokedex) Get(name string) (pokeapi.PokemonDetails, bool) {
+// Replace swaps the whole collection for entries, keeping their IDs. Every
+// current Pokemon is released and every new one restored.
+func (p *Pokedex) Replace(entries []Entry) error {
+	seen := make(map[int]bool, len(entries))
+	for _, entry := range entries {
+		if entry.ID <= 0 {
+			return fmt.Errorf("invalid pokemon ID %d", entry.ID)
+		}
+		if seen[entry.ID] {
+			return fmt.Errorf("duplicate pokemon ID %d", entry.ID)
+		}
+		seen[entry.ID] = true
+	}
+
+	_, err := p.change(func() ([]Event, error) {
+		var changes []Event
+		for _, entry := range p.sorted() {
+			changes = append(changes, Released{Entry: entry})
+		}
+		for _, entry := range entries {
+			changes = append(changes, Restored{Entry: entry})
+		}
+		return changes, nil
+	})
+	return err
+}
+
+// Update replaces a stored entry, such as after it gained experience or
+// evolved. Nicknames and tags only change through Rename and Tag.
+func (p *Pokedex) Update(entry Entry) error {
+	_, err := p.change(func() ([]Event, error) {
+		before, exists := p.pokemon[entry.ID]
+		if !exists {
+			return nil, fmt.Errorf("no pokemon with ID %d", entry.ID)
+		}
+		if entry.Nickname != before.Nickname {
+			return nil, fmt.Errorf("use Rename to change a nickname")
+		}
+		if !slices.Equal(entry.Tags, before.Tags) {
+			return nil, fmt.Errorf("use Tag and Untag to change tags")
+		}
+		switch {
+		case reflect.DeepEqual(entry, before):
+			return nil, nil
+		case entry.Pokemon.Name != before.Pokemon.Name:
+			return []Event{Evolved{Before: before, After: entry}}, nil
+		case entry.Level != before.Level || entry.Experience != before.Experience:
+			return []Event{Leveled{Before: before, After: entry}}, nil
+		}
+		return []Event{Updated{Before: before, After: entry}}, nil
+	})
+	return err
+}
+
+// Remove takes a Pokemon out of the collection and returns it.
+func (p *Pokedex) Remove(id int) (Entry, error) {
+	changes, err := p.change(func() ([]Event, error) {
+		entry, exists := p.pokemon[id]
+		if !exists {
+			return nil, fmt.Errorf("no pokemon with ID %d", id)
+		}
+		return []Event{Released{Entry: entry}}, nil
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+	return changes[0].(Released).Entry, nil
+}
+
+// Rename sets a Pokemon's nickname, or clears it when nickname is empty, and
+// returns the previous one.
+func (p *Pokedex) Rename(id int, nickname string) (string, error) {
+	if err := ValidateNickname(nickname); err != nil {
+		return "", err
+	}
+
+	changes, err := p.change(func() ([]Event, error) {
+		entry, exists := p.pokemon[id]
+		if !exists {
+			return nil, fmt.Errorf("no pokemon with ID %d", id)
+		}
+		previous := entry.Nickname
+		entry.Nickname = nickname
+		return []Event{Renamed{Entry: entry, From: previous}}, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return changes[0].(Renamed).From, nil
+}
+
+func (p *Pokedex) Get(id int) (Entry, bool) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	pokemon, exists := p.pokemon[name]
-	return pokemon, exists
+	entry, exists := p.pokemon[id]
+	return entry, exists
+}
+
+// Find resolves an ID, a nickname or a species name to an entry, preferring
+// the oldest catch when a name matches several.
+func (p *Pokedex) Find(ref string) (Entry, bool) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return p.Get(id)
+	}
+
+	all := p.GetAll()
+	for _, entry := range all {
+		if entry.Nickname != "" && strings.EqualFold(entry.Nickname, ref) {
+			return entry, true
+		}
+	}
+	for _, entry := range all {
+		if entry.Pokemon.Name == ref {
+			return entry, true
+		}
+	}
+	return Entry{}, false
 }
 
-func (p *Pokedex) GetAll() []pokeapi.PokemonDetails {
+// GetAll returns every caught Pokemon ordered by ID.
+func (p *Pokedex) GetAll() []Entry {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	return p.sorted()
+}
 
-	all := make([]pokeapi.PokemonDetails, 0, len(p.pokemon))
-	for _, pokemon := range p.pokemon {
-		all = append(all, pokemon)
+func (p *Pokedex) sorted() []Entry {
+	all := make([]Entry, 0, len(p.pokemon))
+	for _, entry := range p.pokemon {
+		all = append(all, entry)
 	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ID < all[j].ID
+	})
 	return all
 }
 
+// Has reports whether at least one Pokemon of the given species was caught.
 func (p *Pokedex) Has(name string) bool {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	_, exists := p.pokemon[name]
-	return exists
+
+	for _, entry := range p.pokemon {
+		if entry.Pokemon.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Pokedex) Count(name string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	count := 0
+	for _, entry := range p.pokemon {
+		if entry.Pokemon.Name == name {
+			count++
+		}
+	}
+	return count
 }
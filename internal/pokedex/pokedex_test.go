@@ -0,0 +1,222 @@
+package pokedex
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/experience"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+func TestAddAssignsUniqueIDs(t *testing.T) {
+	dex := NewPokedex()
+	pikachu := pokeapi.PokemonDetails{Name: "pikachu"}
+
+	first := dex.Add(Entry{Pokemon: pikachu})
+	second := dex.Add(Entry{Pokemon: pikachu})
+
+	if first.ID == second.ID {
+		t.Errorf("expected distinct IDs, both got %d", first.ID)
+	}
+
+	if got := dex.Count("pikachu"); got != 2 {
+		t.Errorf("expected 2 pikachu, got %d", got)
+	}
+}
+
+func TestRestoreKeepsIDs(t *testing.T) {
+	dex := NewPokedex()
+
+	if err := dex.Restore(Entry{ID: 7, Pokemon: pokeapi.PokemonDetails{Name: "eevee"}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := dex.Restore(Entry{ID: 7}); err == nil {
+		t.Error("expected error restoring a duplicate ID")
+	}
+
+	next := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+	if next.ID != 8 {
+		t.Errorf("expected new catches to continue after restored IDs, got %d", next.ID)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	dex := NewPokedex()
+	dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+
+	err := dex.Replace([]Entry{
+		{ID: 3, Pokemon: pokeapi.PokemonDetails{Name: "eevee"}},
+		{ID: 9, Pokemon: pokeapi.PokemonDetails{Name: "zubat"}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if dex.Has("pikachu") {
+		t.Error("expected the old collection to be gone")
+	}
+
+	if next := dex.Add(Entry{}); next.ID != 10 {
+		t.Errorf("expected new IDs to continue after 9, got %d", next.ID)
+	}
+
+	if err := dex.Replace([]Entry{{ID: 1}, {ID: 1}}); err == nil {
+		t.Error("expected error for duplicate IDs")
+	}
+
+	if len(dex.GetAll()) != 3 {
+		t.Error("expected a failed replace to leave the collection alone")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	dex := NewPokedex()
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}, Level: 5})
+
+	entry.Level = 6
+	if err := dex.Update(entry); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	stored, _ := dex.Get(entry.ID)
+	if stored.Level != 6 {
+		t.Errorf("expected updated level 6, got %d", stored.Level)
+	}
+
+	if err := dex.Update(Entry{ID: 99}); err == nil {
+		t.Error("expected error updating a missing entry")
+	}
+}
+
+func TestGetAllOrderedByID(t *testing.T) {
+	dex := NewPokedex()
+	for _, name := range []string{"zubat", "abra", "mew"} {
+		dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: name}})
+	}
+
+	all := dex.GetAll()
+	for i, entry := range all {
+		if entry.ID != i+1 {
+			t.Errorf("expected ID %d at index %d, got %d", i+1, i, entry.ID)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	dex := NewPokedex()
+	dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+	sparky := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}, Nickname: "Sparky"})
+
+	byID, ok := dex.Find("2")
+	if !ok || byID.ID != sparky.ID {
+		t.Errorf("expected to find entry 2 by ID, got %+v", byID)
+	}
+
+	byNickname, ok := dex.Find("sparky")
+	if !ok || byNickname.ID != sparky.ID {
+		t.Errorf("expected case-insensitive nickname lookup, got %+v", byNickname)
+	}
+
+	bySpecies, ok := dex.Find("pikachu")
+	if !ok || bySpecies.ID != 1 {
+		t.Errorf("expected species lookup to return the oldest catch, got %+v", bySpecies)
+	}
+
+	if _, ok := dex.Find("raichu"); ok {
+		t.Error("expected no match for an uncaught species")
+	}
+}
+
+func TestNewEntry(t *testing.T) {
+	pikachu := pokeapi.PokemonDetails{
+		Name:  "pikachu",
+		Stats: map[string]int{"hp": 35, "attack": 55, "defense": 40, "special-attack": 50, "special-defense": 50, "speed": 90},
+	}
+
+	entry := NewEntry(pikachu, 4, 25, rand.New(rand.NewSource(1)))
+
+	if entry.Level != 25 {
+		t.Errorf("expected level 25, got %d", entry.Level)
+	}
+
+	for name, iv := range entry.IVs {
+		if iv < 0 || iv > 31 {
+			t.Errorf("expected %s IV between 0 and 31, got %d", name, iv)
+		}
+	}
+
+	if entry.Gender != "male" && entry.Gender != "female" {
+		t.Errorf("expected a gendered pikachu, got %s", entry.Gender)
+	}
+
+	if entry.Nature == "" {
+		t.Error("expected a nature to be rolled")
+	}
+
+	if entry.Stats()["hp"] < 52 {
+		t.Errorf("expected hp to be calculated for level 25, got %d", entry.Stats()["hp"])
+	}
+}
+
+func TestNewEntry_Genderless(t *testing.T) {
+	entry := NewEntry(pokeapi.PokemonDetails{Name: "magnemite"}, -1, 5, rand.New(rand.NewSource(1)))
+
+	if entry.Gender != "genderless" {
+		t.Errorf("expected genderless, got %s", entry.Gender)
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	entry := Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}}
+	if entry.DisplayName() != "pikachu" {
+		t.Errorf("expected species name, got %s", entry.DisplayName())
+	}
+
+	entry.Nickname = "Sparky"
+	if entry.DisplayName() != "Sparky" {
+		t.Errorf("expected nickname, got %s", entry.DisplayName())
+	}
+}
+
+func TestGainExperience(t *testing.T) {
+	curve := make(experience.Curve, experience.MaxLevel)
+	for level := 2; level <= experience.MaxLevel; level++ {
+		curve[level-1] = level * level * level
+	}
+
+	entry := Entry{Level: 5, Experience: 125}
+
+	if gained := entry.GainExperience(50, curve); gained != 0 {
+		t.Errorf("expected no level up, got %d", gained)
+	}
+
+	if gained := entry.GainExperience(200, curve); gained != 2 {
+		t.Errorf("expected two levels, got %d", gained)
+	}
+
+	if entry.Level != 7 || entry.Experience != 375 {
+		t.Errorf("expected Lv.7 with 375 XP, got Lv.%d with %d XP", entry.Level, entry.Experience)
+	}
+
+	entry.GainExperience(10000000, curve)
+	if entry.Level != experience.MaxLevel || entry.Experience != curve.MaxExperience() {
+		t.Errorf("expected experience to stop at level 100, got Lv.%d with %d XP", entry.Level, entry.Experience)
+	}
+}
+
+func TestValidateNickname(t *testing.T) {
+	valid := []string{"", "sparky", "pika_chu-2", "twelve-chars", "\u00e9clair"}
+	for _, nickname := range valid {
+		if err := ValidateNickname(nickname); err != nil {
+			t.Errorf("%q: expected no error, got %v", nickname, err)
+		}
+	}
+
+	invalid := []string{"thunderboltmouse", "two words", "bell\x07"}
+	for _, nickname := range invalid {
+		if err := ValidateNickname(nickname); err == nil {
+			t.Errorf("%q: expected an error", nickname)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package pokedex
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+const dateLayout = "2006-01-02"
+
+// Condition is a single filter term such as type:fire or speed>90. Bare words
+// become text conditions that search names and nicknames.
+type Condition struct {
+	Field string
+	Op    string
+	Value string
+}
+
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// Query is a parsed pokedex search, e.g.
+//
+//	type:fire speed>90 sort:-attack limit:10
+//
+// Every condition must hold. A comma separated value such as type:fire,water
+// matches any of its values.
+type Query struct {
+	Conditions []Condition
+	Sort       []SortKey
+	Limit      int
+}
+
+// textFields hold a single word and match exactly.
+var textFields = []string{"nature", "gender", "ball", "area", "region"}
+
+// listFields match when any of the Pokemon's values matches.
+var listFields = []string{"type", "ability", "tag"}
+
+var fieldAliases = map[string]string{
+	"types":      "type",
+	"abilities":  "ability",
+	"tags":       "tag",
+	"lv":         "level",
+	"exp":        "xp",
+	"experience": "xp",
+	"atk":        "attack",
+	"def":        "defense",
+	"spatk":      "special-attack",
+	"spa":        "special-attack",
+	"spdef":      "special-defense",
+	"spd":        "special-defense",
+	"spe":        "speed",
+	"date":       "caught",
+}
+
+// operators are ordered so two character operators are tried first.
+var operators = []string{">=", "<=", "!=", ">", "<", "=", ":"}
+
+func ParseQuery(terms []string) (Query, error) {
+	var q Query
+	for _, term := range terms {
+		if term == "" {
+			continue
+		}
+
+		field, op, value := splitTerm(term)
+		if op == "" {
+			q.Conditions = append(q.Conditions, Condition{Field: "text", Op: ":", Value: term})
+			continue
+		}
+		if value == "" {
+			return Query{}, fmt.Errorf("%s needs a value", term)
+		}
+
+		switch field {
+		case "sort":
+			if op != ":" && op != "=" {
+				return Query{}, fmt.Errorf("sort expects sort:<field>, got %s", term)
+			}
+			keys, err := parseSort(value)
+			if err != nil {
+				return Query{}, err
+			}
+			q.Sort = append(q.Sort, keys...)
+		case "limit":
+			if op != ":" && op != "=" {
+				return Query{}, fmt.Errorf("limit expects limit:<n>, got %s", term)
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return Query{}, fmt.Errorf("limit must be a positive number, got %s", value)
+			}
+			q.Limit = n
+		default:
+			cond, err := parseCondition(field, op, value)
+			if err != nil {
+				return Query{}, err
+			}
+			q.Conditions = append(q.Conditions, cond)
+		}
+	}
+	return q, nil
+}
+
+// splitTerm cuts a term at its first operator. A term without one is a
+// plain search word and comes back with an empty op.
+func splitTerm(term string) (field, op, value string) {
+	at := -1
+	for i := range term {
+		for _, candidate := range operators {
+			if strings.HasPrefix(term[i:], candidate) {
+				at, op = i, candidate
+				break
+			}
+		}
+		if at >= 0 {
+			break
+		}
+	}
+	if at <= 0 {
+		return "", "", term
+	}
+	return canonicalField(term[:at]), op, term[at+len(op):]
+}
+
+func canonicalField(field string) string {
+	field = strings.ToLower(field)
+	if alias, ok := fieldAliases[field]; ok {
+		return alias
+	}
+	return field
+}
+
+func isNumericField(field string) bool {
+	switch field {
+	case "id", "level", "xp", "total":
+		return true
+	}
+	return slices.Contains(stats.Names, field)
+}
+
+func parseCondition(field, op, value string) (Condition, error) {
+	cond := Condition{Field: field, Op: op, Value: value}
+	if op == ":" && field != "caught" {
+		cond.Op = "="
+	}
+
+	switch {
+	case isNumericField(field):
+		if _, err := strconv.Atoi(value); err != nil {
+			return Condition{}, fmt.Errorf("%s must be compared with a number, got %s", field, value)
+		}
+	case field == "caught":
+		if err := validateDates(op, value); err != nil {
+			return Condition{}, err
+		}
+	case field == "name":
+		if cond.Op != "=" {
+			return Condition{}, fmt.Errorf("name only supports name:<text>, got %s", op)
+		}
+		cond.Field, cond.Op = "text", ":"
+	case slices.Contains(textFields, field) || slices.Contains(listFields, field):
+		if cond.Op != "=" && cond.Op != "!=" {
+			return Condition{}, fmt.Errorf("%s only supports : and !=, got %s", field, op)
+		}
+	default:
+		return Condition{}, fmt.Errorf("unknown field %s", field)
+	}
+	return cond, nil
+}
+
+func validateDates(op, value string) error {
+	if from, to, ok := strings.Cut(value, ".."); ok {
+		if op != ":" && op != "=" {
+			return fmt.Errorf("a caught date range expects caught:<from>..<to>, got %s", op)
+		}
+		for _, date := range []string{from, to} {
+			if date == "" {
+				continue
+			}
+			if _, err := time.Parse(dateLayout, date); err != nil {
+				return fmt.Errorf("caught dates look like 2024-05-01, got %s", date)
+			}
+		}
+		return nil
+	}
+
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		return fmt.Errorf("caught dates look like 2024-05-01, got %s", value)
+	}
+	return nil
+}
+
+func parseSort(value string) ([]SortKey, error) {
+	var keys []SortKey
+	for _, part := range strings.Split(value, ",") {
+		key := SortKey{}
+		if strings.HasPrefix(part, "-") {
+			key.Desc = true
+			part = part[1:]
+		} else {
+			part = strings.TrimPrefix(part, "+")
+		}
+
+		key.Field = canonicalField(part)
+		if key.Field != "name" && key.Field != "caught" && !isNumericField(key.Field) {
+			return nil, fmt.Errorf("can't sort by %s", part)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Apply filters, sorts and limits entries. Without a sort key entries keep
+// their given order.
+func (q Query) Apply(entries []Entry) []Entry {
+	var matched []Entry
+	for _, entry := range entries {
+		if q.Matches(entry) {
+			matched = append(matched, entry)
+		}
+	}
+
+	if len(q.Sort) > 0 {
+		sort.SliceStable(matched, func(i, j int) bool {
+			for _, key := range q.Sort {
+				c := compareBy(matched[i], matched[j], key.Field)
+				if c == 0 {
+					continue
+				}
+				if key.Desc {
+					return c > 0
+				}
+				return c < 0
+			}
+			return false
+		})
+	}
+
+	if q.Limit > 0 && len(matched) > q.Limit {
+		matched = matched[:q.Limit]
+	}
+	return matched
+}
+
+func (q Query) Matches(entry Entry) bool {
+	for _, cond := range q.Conditions {
+		if !cond.Matches(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c Condition) Matches(entry Entry) bool {
+	switch {
+	case c.Field == "text":
+		return strings.Contains(strings.ToLower(entry.Pokemon.Name), strings.ToLower(c.Value)) ||
+			strings.Contains(strings.ToLower(entry.Nickname), strings.ToLower(c.Value))
+	case c.Field == "caught":
+		return matchDate(entry.CaughtAt, c.Op, c.Value)
+	case isNumericField(c.Field):
+		want, _ := strconv.Atoi(c.Value)
+		return compareInts(numericValue(entry, c.Field), c.Op, want)
+	}
+
+	var have []string
+	switch c.Field {
+	case "type":
+		have = entry.Pokemon.Types
+	case "ability":
+		have = entry.Pokemon.Abilities
+	case "tag":
+		have = entry.Tags
+	case "nature":
+		have = []string{entry.Nature}
+	case "gender":
+		have = []string{entry.Gender}
+	case "ball":
+		have = []string{entry.Ball}
+	case "area":
+		have = []string{entry.Area}
+	case "region":
+		have = []string{entry.Region}
+	}
+
+	found := false
+	for _, want := range strings.Split(c.Value, ",") {
+		for _, value := range have {
+			if strings.EqualFold(value, want) {
+				found = true
+			}
+		}
+	}
+	if c.Op == "!=" {
+		return !found
+	}
+	return found
+}
+
+func numericValue(entry Entry, field string) int {
+	switch field {
+	case "id":
+		return entry.ID
+	case "level":
+		return entry.Level
+	case "xp":
+		return entry.Experience
+	case "total":
+		total := 0
+		for _, value := range entry.Stats() {
+			total += value
+		}
+		return total
+	}
+	return entry.Stats()[field]
+}
+
+func compareInts(have int, op string, want int) bool {
+	switch op {
+	case "=":
+		return have == want
+	case "!=":
+		return have != want
+	case ">":
+		return have > want
+	case ">=":
+		return have >= want
+	case "<":
+		return have < want
+	case "<=":
+		return have <= want
+	}
+	return false
+}
+
+// matchDate compares the day a Pokemon was caught. Ranges include both ends
+// and either end may be left open.
+func matchDate(caughtAt time.Time, op, value string) bool {
+	day := caughtAt.Format(dateLayout)
+
+	if from, to, ok := strings.Cut(value, ".."); ok {
+		return (from == "" || day >= from) && (to == "" || day <= to)
+	}
+
+	switch op {
+	case ":", "=":
+		return day == value
+	case "!=":
+		return day != value
+	case ">":
+		return day > value
+	case ">=":
+		return day >= value
+	case "<":
+		return day < value
+	case "<=":
+		return day <= value
+	}
+	return false
+}
+
+func compareBy(a, b Entry, field string) int {
+	switch field {
+	case "name":
+		return strings.Compare(a.DisplayName(), b.DisplayName())
+	case "caught":
+		return a.CaughtAt.Compare(b.CaughtAt)
+	}
+	x, y := numericValue(a, field), numericValue(b, field)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,191 @@
+package pokedex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		input    []string
+		expected Query
+	}{
+		{
+			input:    nil,
+			expected: Query{},
+		},
+		{
+			input: []string{"type:fire", "speed>90", "sort:-attack", "limit:10"},
+			expected: Query{
+				Conditions: []Condition{
+					{Field: "type", Op: "=", Value: "fire"},
+					{Field: "speed", Op: ">", Value: "90"},
+				},
+				Sort:  []SortKey{{Field: "attack", Desc: true}},
+				Limit: 10,
+			},
+		},
+		{
+			input: []string{"pika", "name:chu"},
+			expected: Query{
+				Conditions: []Condition{
+					{Field: "text", Op: ":", Value: "pika"},
+					{Field: "text", Op: ":", Value: "chu"},
+				},
+			},
+		},
+		{
+			input: []string{"hp>=50", "def<=40", "level!=5", "spe=90", "lv:12"},
+			expected: Query{
+				Conditions: []Condition{
+					{Field: "hp", Op: ">=", Value: "50"},
+					{Field: "defense", Op: "<=", Value: "40"},
+					{Field: "level", Op: "!=", Value: "5"},
+					{Field: "speed", Op: "=", Value: "90"},
+					{Field: "level", Op: "=", Value: "12"},
+				},
+			},
+		},
+		{
+			input: []string{"type!=water", "ability:blaze,solar-power", "region:kanto"},
+			expected: Query{
+				Conditions: []Condition{
+					{Field: "type", Op: "!=", Value: "water"},
+					{Field: "ability", Op: "=", Value: "blaze,solar-power"},
+					{Field: "region", Op: "=", Value: "kanto"},
+				},
+			},
+		},
+		{
+			input: []string{"caught:2024-01-01..2024-02-01", "caught>=2023-12-31", "caught:..2024-03-01"},
+			expected: Query{
+				Conditions: []Condition{
+					{Field: "caught", Op: ":", Value: "2024-01-01..2024-02-01"},
+					{Field: "caught", Op: ">=", Value: "2023-12-31"},
+					{Field: "caught", Op: ":", Value: "..2024-03-01"},
+				},
+			},
+		},
+		{
+			input: []string{"sort:level,-speed", "sort:+name"},
+			expected: Query{
+				Sort: []SortKey{
+					{Field: "level"},
+					{Field: "speed", Desc: true},
+					{Field: "name"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseQuery(tt.input)
+		if err != nil {
+			t.Errorf("ParseQuery(%v): unexpected error %v", tt.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("ParseQuery(%v):\nexpected %+v\ngot      %+v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	tests := [][]string{
+		{"speed>fast"},
+		{"color:red"},
+		{"type>fire"},
+		{"tag>starter"},
+		{"type:"},
+		{"limit:0"},
+		{"limit:ten"},
+		{"limit>5"},
+		{"sort:color"},
+		{"sort>level"},
+		{"caught:yesterday"},
+		{"caught:2024-01-01..soon"},
+		{"caught>2024-01-01..2024-02-01"},
+		{"name>pika"},
+	}
+
+	for _, input := range tests {
+		if _, err := ParseQuery(input); err == nil {
+			t.Errorf("ParseQuery(%v): expected an error", input)
+		}
+	}
+}
+
+func queryEntries() []Entry {
+	base := func(hp, attack, speed int) map[string]int {
+		return map[string]int{"hp": hp, "attack": attack, "defense": 50, "special-attack": 50, "special-defense": 50, "speed": speed}
+	}
+	day := func(d int) time.Time {
+		return time.Date(2024, 1, d, 12, 0, 0, 0, time.Local)
+	}
+
+	return []Entry{
+		{ID: 1, Level: 50, Nature: "hardy", CaughtAt: day(1), Region: "kanto", Pokemon: pokeapi.PokemonDetails{
+			Name: "charmander", Types: []string{"fire"}, Abilities: []string{"blaze"}, Stats: base(39, 52, 65)}},
+		{ID: 2, Level: 50, Nature: "hardy", CaughtAt: day(5), Region: "kanto", Nickname: "sparky", Tags: []string{"favorite", "starter"}, Pokemon: pokeapi.PokemonDetails{
+			Name: "pikachu", Types: []string{"electric"}, Abilities: []string{"static"}, Stats: base(35, 55, 90)}},
+		{ID: 3, Level: 50, Nature: "hardy", CaughtAt: day(10), Region: "kanto", Pokemon: pokeapi.PokemonDetails{
+			Name: "ponyta", Types: []string{"fire"}, Abilities: []string{"run-away", "flash-fire"}, Stats: base(50, 85, 90)}},
+		{ID: 4, Level: 20, Nature: "hardy", CaughtAt: day(20), Region: "johto", Pokemon: pokeapi.PokemonDetails{
+			Name: "cyndaquil", Types: []string{"fire"}, Abilities: []string{"blaze"}, Stats: base(39, 52, 65)}},
+	}
+}
+
+func applyQuery(t *testing.T, terms ...string) []int {
+	t.Helper()
+	q, err := ParseQuery(terms)
+	if err != nil {
+		t.Fatalf("ParseQuery(%v): unexpected error %v", terms, err)
+	}
+
+	var ids []int
+	for _, entry := range q.Apply(queryEntries()) {
+		ids = append(ids, entry.ID)
+	}
+	return ids
+}
+
+func TestQueryApply(t *testing.T) {
+	tests := []struct {
+		terms    []string
+		expected []int
+	}{
+		{[]string{}, []int{1, 2, 3, 4}},
+		{[]string{"type:fire"}, []int{1, 3, 4}},
+		{[]string{"type:fire,electric"}, []int{1, 2, 3, 4}},
+		{[]string{"type!=fire"}, []int{2}},
+		{[]string{"ability:blaze"}, []int{1, 4}},
+		{[]string{"region:johto"}, []int{4}},
+		{[]string{"tag:starter"}, []int{2}},
+		{[]string{"tags:starter,legendary"}, []int{2}},
+		{[]string{"tag!=favorite"}, []int{1, 3, 4}},
+		{[]string{"speed>95"}, nil},
+		{[]string{"speed>=95"}, []int{2, 3}},
+		{[]string{"type:fire", "speed>=95"}, []int{3}},
+		{[]string{"level<50"}, []int{4}},
+		{[]string{"chu"}, []int{2}},
+		{[]string{"SPARK"}, []int{2}},
+		{[]string{"caught:2024-01-05"}, []int{2}},
+		{[]string{"caught:2024-01-02..2024-01-10"}, []int{2, 3}},
+		{[]string{"caught:2024-01-10.."}, []int{3, 4}},
+		{[]string{"caught<2024-01-05"}, []int{1}},
+		{[]string{"sort:-attack"}, []int{3, 2, 1, 4}},
+		{[]string{"sort:-speed,name"}, []int{3, 2, 1, 4}},
+		{[]string{"sort:level,-id"}, []int{4, 3, 2, 1}},
+		{[]string{"type:fire", "sort:-attack", "limit:2"}, []int{3, 1}},
+	}
+
+	for _, tt := range tests {
+		got := applyQuery(t, tt.terms...)
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%v: expected %v, got %v", tt.terms, tt.expected, got)
+		}
+	}
+}
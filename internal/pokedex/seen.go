@@ -0,0 +1,77 @@
+package pokedex
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Sighting records the first time a Pokemon was seen.
+type Sighting struct {
+	Name      string
+	Area      string
+	FirstSeen time.Time
+}
+
+// Seen tracks every Pokemon the player has come across, caught or not.
+type Seen struct {
+	mu        sync.Mutex
+	sightings map[string]Sighting
+}
+
+func NewSeen() *Seen {
+	return &Seen{
+		sightings: make(map[string]Sighting),
+	}
+}
+
+// Mark records a sighting unless name was already seen, and reports whether
+// it was new.
+func (s *Seen) Mark(name, area string, at time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.sightings[name]; exists {
+		return false
+	}
+	s.sightings[name] = Sighting{Name: name, Area: area, FirstSeen: at}
+	return true
+}
+
+// Restore puts back a sighting read from a save.
+func (s *Seen) Restore(sighting Sighting) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sightings[sighting.Name] = sighting
+}
+
+func (s *Seen) Get(name string) (Sighting, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sighting, exists := s.sightings[name]
+	return sighting, exists
+}
+
+func (s *Seen) Count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.sightings)
+}
+
+// GetAll returns every sighting, oldest first.
+func (s *Seen) GetAll() []Sighting {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all := make([]Sighting, 0, len(s.sightings))
+	for _, sighting := range s.sightings {
+		all = append(all, sighting)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].FirstSeen.Equal(all[j].FirstSeen) {
+			return all[i].FirstSeen.Before(all[j].FirstSeen)
+		}
+		return all[i].Name < all[j].Name
+	})
+	return all
+}
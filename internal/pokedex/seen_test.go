@@ -0,0 +1,46 @@
+package pokedex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSeenKeepsFirstSighting(t *testing.T) {
+	seen := NewSeen()
+	first := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	if !seen.Mark("pikachu", "viridian-forest-area", first) {
+		t.Error("expected the first sighting to be new")
+	}
+
+	if seen.Mark("pikachu", "power-plant-area", first.Add(time.Hour)) {
+		t.Error("expected a repeat sighting not to be new")
+	}
+
+	sighting, ok := seen.Get("pikachu")
+	if !ok {
+		t.Fatal("expected pikachu to be seen")
+	}
+
+	if sighting.Area != "viridian-forest-area" || !sighting.FirstSeen.Equal(first) {
+		t.Errorf("expected the first sighting to be kept, got %+v", sighting)
+	}
+}
+
+func TestSeenGetAllOldestFirst(t *testing.T) {
+	seen := NewSeen()
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	seen.Mark("zubat", "", now.Add(time.Minute))
+	seen.Mark("pidgey", "", now)
+	seen.Restore(Sighting{Name: "rattata", FirstSeen: now})
+
+	all := seen.GetAll()
+	if len(all) != 3 || seen.Count() != 3 {
+		t.Fatalf("expected 3 sightings, got %d", len(all))
+	}
+
+	if all[0].Name != "pidgey" || all[1].Name != "rattata" || all[2].Name != "zubat" {
+		t.Errorf("expected pidgey, rattata, zubat, got %v", all)
+	}
+}
@@ -0,0 +1,267 @@
+package pokedex
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+const (
+	PartySize = 6
+	BoxCount  = 8
+	BoxSize   = 30
+)
+
+// Location says where a Pokemon is kept. Box 0 is the party, boxes are
+// numbered from 1. Slot is the zero-based index inside the party or box.
+type Location struct {
+	Box  int
+	Slot int
+}
+
+func (l Location) String() string {
+	if l.Box == 0 {
+		return "your party"
+	}
+	return fmt.Sprintf("Box %d", l.Box)
+}
+
+// Storage tracks which caught Pokemon, by ID, travel in the party and which
+// are kept in the PC boxes.
+type Storage struct {
+	mu    sync.Mutex
+	party []int
+	boxes [][]int
+}
+
+func NewStorage() *Storage {
+	return &Storage{
+		party: make([]int, 0, PartySize),
+		boxes: make([][]int, BoxCount),
+	}
+}
+
+// Restore replaces the storage layout, as read from a save file. A Pokemon
+// stored in more than one slot keeps only the first, with the party before
+// the boxes.
+func (s *Storage) Restore(party []int, boxes [][]int) error {
+	if len(party) > PartySize {
+		return errors.New("party holds more than six pokemon")
+	}
+	if len(boxes) > BoxCount {
+		return fmt.Errorf("save has %d boxes, only %d are available", len(boxes), BoxCount)
+	}
+
+	stored := make(map[int]bool)
+	unique := func(ids []int) []int {
+		kept := ids[:0:0]
+		for _, id := range ids {
+			if !stored[id] {
+				stored[id] = true
+				kept = append(kept, id)
+			}
+		}
+		return kept
+	}
+
+	restoredParty := unique(party)
+	restored := make([][]int, BoxCount)
+	for i, box := range boxes {
+		if len(box) > BoxSize {
+			return fmt.Errorf("box %d holds more than %d pokemon", i+1, BoxSize)
+		}
+		restored[i] = unique(box)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.party = restoredParty
+	s.boxes = restored
+	return nil
+}
+
+// Place puts a newly caught Pokemon in the party, or in the first box with
+// room once the party is full.
+func (s *Storage) Place(id int) (Location, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.party) < PartySize {
+		s.party = append(s.party, id)
+		return Location{Box: 0, Slot: len(s.party) - 1}, nil
+	}
+
+	for i, box := range s.boxes {
+		if len(box) < BoxSize {
+			s.boxes[i] = append(box, id)
+			return Location{Box: i + 1, Slot: len(s.boxes[i]) - 1}, nil
+		}
+	}
+	return Location{}, errors.New("your party and every box are full")
+}
+
+func (s *Storage) Full() bool {
+	return s.Free() == 0
+}
+
+// Free counts the empty slots left in the party and every box.
+func (s *Storage) Free() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	free := PartySize - len(s.party)
+	for _, box := range s.boxes {
+		free += BoxSize - len(box)
+	}
+	return free
+}
+
+func (s *Storage) Party() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.party)
+}
+
+func (s *Storage) Box(n int) ([]int, error) {
+	if n < 1 || n > BoxCount {
+		return nil, fmt.Errorf("box must be between 1 and %d", BoxCount)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.boxes[n-1]), nil
+}
+
+func (s *Storage) Boxes() [][]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	boxes := make([][]int, len(s.boxes))
+	for i, box := range s.boxes {
+		boxes[i] = slices.Clone(box)
+	}
+	return boxes
+}
+
+func (s *Storage) Locate(id int) (Location, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.locate(id)
+}
+
+func (s *Storage) locate(id int) (Location, bool) {
+	if i := slices.Index(s.party, id); i >= 0 {
+		return Location{Box: 0, Slot: i}, true
+	}
+	for b, box := range s.boxes {
+		if i := slices.Index(box, id); i >= 0 {
+			return Location{Box: b + 1, Slot: i}, true
+		}
+	}
+	return Location{}, false
+}
+
+func (s *Storage) list(box int) *[]int {
+	if box == 0 {
+		return &s.party
+	}
+	return &s.boxes[box-1]
+}
+
+func (s *Storage) capacity(box int) int {
+	if box == 0 {
+		return PartySize
+	}
+	return BoxSize
+}
+
+// Remove takes a Pokemon out of storage entirely.
+func (s *Storage) Remove(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if loc, ok := s.locate(id); ok {
+		list := s.list(loc.Box)
+		*list = slices.Delete(*list, loc.Slot, loc.Slot+1)
+	}
+}
+
+// MoveTo moves a Pokemon into the given box, where box 0 is the party. The
+// party can never be left empty.
+func (s *Storage) MoveTo(id int, box int) (Location, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.moveTo(id, box)
+}
+
+func (s *Storage) moveTo(id int, box int) (Location, error) {
+	if box < 0 || box > BoxCount {
+		return Location{}, fmt.Errorf("box must be between 1 and %d", BoxCount)
+	}
+
+	from, ok := s.locate(id)
+	if !ok {
+		return Location{}, errors.New("that pokemon isn't in storage")
+	}
+	if from.Box == box {
+		return from, fmt.Errorf("it's already in %s", from)
+	}
+	if from.Box == 0 && len(s.party) == 1 {
+		return Location{}, errors.New("you can't leave your party empty")
+	}
+
+	dest := s.list(box)
+	if len(*dest) >= s.capacity(box) {
+		return Location{}, fmt.Errorf("%s is full", Location{Box: box})
+	}
+
+	src := s.list(from.Box)
+	*src = slices.Delete(*src, from.Slot, from.Slot+1)
+	*dest = append(*dest, id)
+	return Location{Box: box, Slot: len(*dest) - 1}, nil
+}
+
+// Deposit sends a party member to the first box with room.
+func (s *Storage) Deposit(id int) (Location, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if from, ok := s.locate(id); !ok || from.Box != 0 {
+		return Location{}, errors.New("that pokemon isn't in your party")
+	}
+
+	for i, box := range s.boxes {
+		if len(box) < BoxSize {
+			return s.moveTo(id, i+1)
+		}
+	}
+	return Location{}, errors.New("every box is full")
+}
+
+// Withdraw brings a boxed Pokemon into the party.
+func (s *Storage) Withdraw(id int) (Location, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if from, ok := s.locate(id); !ok || from.Box == 0 {
+		return Location{}, errors.New("that pokemon isn't in a box")
+	}
+	return s.moveTo(id, 0)
+}
+
+// Swap exchanges the places of two Pokemon, wherever they are kept.
+func (s *Storage) Swap(a, b int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	locA, okA := s.locate(a)
+	locB, okB := s.locate(b)
+	if !okA || !okB {
+		return errors.New("both pokemon must be in storage")
+	}
+
+	listA, listB := s.list(locA.Box), s.list(locB.Box)
+	(*listA)[locA.Slot], (*listB)[locB.Slot] = (*listB)[locB.Slot], (*listA)[locA.Slot]
+	return nil
+}
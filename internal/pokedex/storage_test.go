@@ -0,0 +1,179 @@
+package pokedex
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlaceFillsPartyThenBoxes(t *testing.T) {
+	s := NewStorage()
+
+	for id := 1; id <= PartySize; id++ {
+		loc, err := s.Place(id)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if loc.Box != 0 {
+			t.Errorf("expected pokemon %d in the party, got box %d", id, loc.Box)
+		}
+	}
+
+	loc, err := s.Place(7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if loc.Box != 1 || loc.Slot != 0 {
+		t.Errorf("expected the seventh pokemon in box 1 slot 0, got %+v", loc)
+	}
+}
+
+func TestPlaceWhenEverythingIsFull(t *testing.T) {
+	s := NewStorage()
+
+	total := PartySize + BoxCount*BoxSize
+	if s.Free() != total {
+		t.Errorf("expected %d free slots, got %d", total, s.Free())
+	}
+
+	for id := 1; id <= total; id++ {
+		if _, err := s.Place(id); err != nil {
+			t.Fatalf("expected room for pokemon %d, got %v", id, err)
+		}
+	}
+
+	if !s.Full() {
+		t.Error("expected storage to be full")
+	}
+	if _, err := s.Place(total + 1); err == nil {
+		t.Error("expected error placing into full storage")
+	}
+}
+
+func TestDepositAndWithdraw(t *testing.T) {
+	s := NewStorage()
+	s.Place(1)
+	s.Place(2)
+
+	loc, err := s.Deposit(2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if loc.Box != 1 {
+		t.Errorf("expected deposit into box 1, got %d", loc.Box)
+	}
+
+	if _, err := s.Deposit(1); err == nil {
+		t.Error("expected error depositing the last party member")
+	}
+
+	if _, err := s.Withdraw(1); err == nil {
+		t.Error("expected error withdrawing a pokemon that's in the party")
+	}
+
+	loc, err = s.Withdraw(2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if loc.Box != 0 || loc.Slot != 1 {
+		t.Errorf("expected pokemon 2 back in party slot 1, got %+v", loc)
+	}
+}
+
+func TestMoveTo(t *testing.T) {
+	s := NewStorage()
+	s.Place(1)
+	s.Place(2)
+
+	if _, err := s.MoveTo(2, 3); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	box, _ := s.Box(3)
+	if len(box) != 1 || box[0] != 2 {
+		t.Errorf("expected box 3 to hold pokemon 2, got %v", box)
+	}
+
+	if _, err := s.MoveTo(2, 3); err == nil {
+		t.Error("expected error moving into the box it's already in")
+	}
+
+	if _, err := s.MoveTo(2, BoxCount+1); err == nil {
+		t.Error("expected error for a box that doesn't exist")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	s := NewStorage()
+	s.Place(1)
+	s.Place(2)
+	s.MoveTo(2, 1)
+	s.Place(3)
+
+	if err := s.Swap(1, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	party := s.Party()
+	if party[0] != 2 {
+		t.Errorf("expected pokemon 2 to lead the party, got %v", party)
+	}
+
+	loc, _ := s.Locate(1)
+	if loc.Box != 1 {
+		t.Errorf("expected pokemon 1 in box 1, got %+v", loc)
+	}
+
+	if err := s.Swap(1, 99); err == nil {
+		t.Error("expected error swapping with an unknown pokemon")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	s := NewStorage()
+
+	err := s.Restore([]int{4, 5}, [][]int{{1}, {2, 3}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if loc, _ := s.Locate(3); loc.Box != 2 || loc.Slot != 1 {
+		t.Errorf("expected pokemon 3 in box 2 slot 1, got %+v", loc)
+	}
+
+	if len(s.Boxes()) != BoxCount {
+		t.Errorf("expected %d boxes after restore, got %d", BoxCount, len(s.Boxes()))
+	}
+
+	if err := s.Restore([]int{4, 5, 4}, [][]int{{1, 5}, {1, 2}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if party := s.Party(); !slices.Equal(party, []int{4, 5}) {
+		t.Errorf("expected pokemon stored twice to keep their first slot, got party %v", party)
+	}
+	if boxes := s.Boxes(); !slices.Equal(boxes[0], []int{1}) || !slices.Equal(boxes[1], []int{2}) {
+		t.Errorf("expected the repeats dropped from the boxes, got %v", boxes[:2])
+	}
+	s.Remove(1)
+	if _, ok := s.Locate(1); ok {
+		t.Error("expected a released pokemon to leave no slot behind")
+	}
+
+	if err := s.Restore([]int{1, 2, 3, 4, 5, 6, 7}, nil); err == nil {
+		t.Error("expected error restoring an oversized party")
+	}
+}
+
+func TestRemove(t *testing.T) {
+	s := NewStorage()
+	s.Place(1)
+	s.Place(2)
+
+	s.Remove(1)
+
+	if _, ok := s.Locate(1); ok {
+		t.Error("expected pokemon 1 to be gone")
+	}
+	if party := s.Party(); len(party) != 1 || party[0] != 2 {
+		t.Errorf("expected only pokemon 2 left, got %v", party)
+	}
+}
@@ -0,0 +1,83 @@
+package pokedex
+
+import (
+	"slices"
+
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+// Species returns the distinct species among entries in the order they were
+// first caught.
+func Species(entries []Entry) []string {
+	var species []string
+	for _, entry := range entries {
+		name := entry.Pokemon.SpeciesName()
+		if !slices.Contains(species, name) {
+			species = append(species, name)
+		}
+	}
+	return species
+}
+
+// CountByType counts distinct species per type. A dual-type species counts
+// towards both of its types.
+func CountByType(entries []Entry) map[string]int {
+	seen := make(map[string]bool)
+	counts := make(map[string]int)
+	for _, entry := range entries {
+		name := entry.Pokemon.SpeciesName()
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		for _, typeName := range entry.Pokemon.Types {
+			counts[typeName]++
+		}
+	}
+	return counts
+}
+
+// CountByRegion counts distinct species per region of origin. Species with
+// an unknown origin are left out.
+func CountByRegion(entries []Entry) map[string]int {
+	seen := make(map[string]bool)
+	counts := make(map[string]int)
+	for _, entry := range entries {
+		name := entry.Pokemon.SpeciesName()
+		if seen[name] || entry.Region == "" {
+			continue
+		}
+		seen[name] = true
+		counts[entry.Region]++
+	}
+	return counts
+}
+
+// AverageBaseStats averages the base stats of every caught Pokemon.
+func AverageBaseStats(entries []Entry) map[string]float64 {
+	averages := make(map[string]float64, len(stats.Names))
+	if len(entries) == 0 {
+		return averages
+	}
+
+	for _, entry := range entries {
+		for _, name := range stats.Names {
+			averages[name] += float64(entry.Pokemon.Stats[name])
+		}
+	}
+	for _, name := range stats.Names {
+		averages[name] /= float64(len(entries))
+	}
+	return averages
+}
+
+// Completion counts how many species of a dex appear in have.
+func Completion(have, dex []string) int {
+	count := 0
+	for _, name := range dex {
+		if slices.Contains(have, name) {
+			count++
+		}
+	}
+	return count
+}
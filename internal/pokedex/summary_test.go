@@ -0,0 +1,78 @@
+package pokedex
+
+import (
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+func summaryEntries() []Entry {
+	return []Entry{
+		{ID: 1, Region: "kanto", Pokemon: pokeapi.PokemonDetails{
+			Name: "pikachu", Types: []string{"electric"}, Stats: map[string]int{"hp": 35, "speed": 90}}},
+		{ID: 2, Region: "kanto", Pokemon: pokeapi.PokemonDetails{
+			Name: "pikachu", Types: []string{"electric"}, Stats: map[string]int{"hp": 35, "speed": 90}}},
+		{ID: 3, Region: "kanto", Pokemon: pokeapi.PokemonDetails{
+			Name: "charizard", Types: []string{"fire", "flying"}, Stats: map[string]int{"hp": 78, "speed": 100}}},
+		{ID: 4, Region: "johto", Pokemon: pokeapi.PokemonDetails{
+			Name: "deoxys-speed", Species: "deoxys", Types: []string{"psychic"}, Stats: map[string]int{"hp": 52, "speed": 180}}},
+	}
+}
+
+func TestSpecies(t *testing.T) {
+	species := Species(summaryEntries())
+
+	expected := []string{"pikachu", "charizard", "deoxys"}
+	if len(species) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, species)
+	}
+	for i := range expected {
+		if species[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, species)
+		}
+	}
+}
+
+func TestCountByType(t *testing.T) {
+	counts := CountByType(summaryEntries())
+
+	if counts["electric"] != 1 {
+		t.Errorf("expected duplicates to count once, got %d electric", counts["electric"])
+	}
+
+	if counts["fire"] != 1 || counts["flying"] != 1 {
+		t.Errorf("expected dual types to count towards both, got %v", counts)
+	}
+}
+
+func TestCountByRegion(t *testing.T) {
+	counts := CountByRegion(summaryEntries())
+
+	if counts["kanto"] != 2 || counts["johto"] != 1 {
+		t.Errorf("expected 2 kanto and 1 johto species, got %v", counts)
+	}
+}
+
+func TestAverageBaseStats(t *testing.T) {
+	averages := AverageBaseStats(summaryEntries())
+
+	if averages["hp"] != 50 {
+		t.Errorf("expected average hp 50, got %v", averages["hp"])
+	}
+
+	if averages["speed"] != 115 {
+		t.Errorf("expected average speed 115, got %v", averages["speed"])
+	}
+
+	if len(AverageBaseStats(nil)) != 0 {
+		t.Error("expected no averages for an empty collection")
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	dex := []string{"bulbasaur", "charizard", "pikachu"}
+
+	if got := Completion(Species(summaryEntries()), dex); got != 2 {
+		t.Errorf("expected 2 of 3, got %d", got)
+	}
+}
@@ -0,0 +1,90 @@
+package pokedex
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// FavoriteTag marks the Pokemon a trainer starred with the favorite command.
+const FavoriteTag = "favorite"
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9_-]{1,20}$`)
+
+func ValidateTag(tag string) error {
+	if !tagPattern.MatchString(tag) {
+		return fmt.Errorf("tags are 1 to 20 lowercase letters, digits, - or _, got %q", tag)
+	}
+	return nil
+}
+
+func (e Entry) HasTag(tag string) bool {
+	return slices.Contains(e.Tags, tag)
+}
+
+// Tag adds tags to a Pokemon and returns the updated entry.
+func (p *Pokedex) Tag(id int, tags ...string) (Entry, error) {
+	return p.retag(id, func(current []string) []string {
+		return append(current, tags...)
+	}, tags)
+}
+
+// Untag removes tags from a Pokemon and returns the updated entry. Tags it
+// doesn't have are ignored.
+func (p *Pokedex) Untag(id int, tags ...string) (Entry, error) {
+	return p.retag(id, func(current []string) []string {
+		return slices.DeleteFunc(current, func(tag string) bool {
+			return slices.Contains(tags, tag)
+		})
+	}, tags)
+}
+
+// SetTags replaces every tag of a Pokemon and returns the updated entry.
+func (p *Pokedex) SetTags(id int, tags []string) (Entry, error) {
+	return p.retag(id, func([]string) []string {
+		return slices.Clone(tags)
+	}, tags)
+}
+
+func (p *Pokedex) retag(id int, edit func([]string) []string, tags []string) (Entry, error) {
+	for _, tag := range tags {
+		if err := ValidateTag(tag); err != nil {
+			return Entry{}, err
+		}
+	}
+
+	changes, err := p.change(func() ([]Event, error) {
+		entry, exists := p.pokemon[id]
+		if !exists {
+			return nil, fmt.Errorf("no pokemon with ID %d", id)
+		}
+		previous := entry.Tags
+		entry.Tags = normalizeTags(edit(slices.Clone(previous)))
+		return []Event{Retagged{Entry: entry, From: previous}}, nil
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+	return changes[0].(Retagged).Entry, nil
+}
+
+// normalizeTags sorts tags and drops duplicates, leaving nil for none.
+func normalizeTags(tags []string) []string {
+	slices.Sort(tags)
+	tags = slices.Compact(tags)
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// TagCounts returns how many Pokemon carry each tag.
+func TagCounts(entries []Entry) map[string]int {
+	counts := make(map[string]int)
+	for _, entry := range entries {
+		for _, tag := range entry.Tags {
+			counts[tag]++
+		}
+	}
+	return counts
+}
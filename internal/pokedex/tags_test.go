@@ -0,0 +1,93 @@
+package pokedex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+)
+
+func TestTagAndUntag(t *testing.T) {
+	dex := NewPokedex()
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+
+	tagged, err := dex.Tag(entry.ID, "starter", FavoriteTag, "starter")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if expected := []string{"favorite", "starter"}; !reflect.DeepEqual(tagged.Tags, expected) {
+		t.Errorf("expected sorted unique tags %v, got %v", expected, tagged.Tags)
+	}
+	if !tagged.HasTag(FavoriteTag) {
+		t.Error("expected pikachu to be a favorite")
+	}
+
+	untagged, err := dex.Untag(entry.ID, "favorite", "shiny-hunt")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if expected := []string{"starter"}; !reflect.DeepEqual(untagged.Tags, expected) {
+		t.Errorf("expected %v, got %v", expected, untagged.Tags)
+	}
+
+	if stored, _ := dex.Get(entry.ID); !reflect.DeepEqual(stored.Tags, untagged.Tags) {
+		t.Errorf("expected the stored tags to match, got %v", stored.Tags)
+	}
+
+	cleared, _ := dex.Untag(entry.ID, "starter")
+	if cleared.Tags != nil {
+		t.Errorf("expected no tags left, got %v", cleared.Tags)
+	}
+}
+
+func TestTag_Errors(t *testing.T) {
+	dex := NewPokedex()
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+
+	for _, tag := range []string{"", "Starter", "has space", "waytoolongforatagreally"} {
+		if _, err := dex.Tag(entry.ID, tag); err == nil {
+			t.Errorf("expected error for tag %q", tag)
+		}
+	}
+
+	if _, err := dex.Tag(99, "starter"); err == nil {
+		t.Error("expected error tagging a missing pokemon")
+	}
+
+	entry.Tags = []string{"starter"}
+	if err := dex.Update(entry); err == nil {
+		t.Error("expected Update to refuse a tag change")
+	}
+}
+
+func TestJournalUndoRetag(t *testing.T) {
+	dex := NewPokedex()
+	journal := NewJournal(10)
+	entry := dex.Add(Entry{Pokemon: pokeapi.PokemonDetails{Name: "pikachu"}})
+
+	tagged, _ := dex.Tag(entry.ID, "starter")
+	journal.Record(Mutation{Op: OpRetag, Entry: tagged, FromTags: entry.Tags, ToTags: tagged.Tags})
+
+	journal.Undo(dex)
+	if stored, _ := dex.Get(entry.ID); stored.Tags != nil {
+		t.Errorf("expected the tag to be undone, got %v", stored.Tags)
+	}
+
+	journal.Redo(dex)
+	if stored, _ := dex.Get(entry.ID); !stored.HasTag("starter") {
+		t.Errorf("expected the tag to be redone, got %v", stored.Tags)
+	}
+}
+
+func TestTagCounts(t *testing.T) {
+	counts := TagCounts([]Entry{
+		{Tags: []string{"favorite", "starter"}},
+		{Tags: []string{"starter"}},
+		{},
+	})
+
+	expected := map[string]int{"favorite": 1, "starter": 2}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v, got %v", expected, counts)
+	}
+}
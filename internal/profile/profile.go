@@ -0,0 +1,158 @@
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/save"
+)
+
+const DefaultName = "default"
+
+var validName = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// Manager keeps one save file per trainer profile under root/profiles and
+// remembers the last active profile in root/profiles.json.
+type Manager struct {
+	root string
+}
+
+type managerState struct {
+	Active string `json:"active"`
+}
+
+func NewManager(root string) *Manager {
+	return &Manager{root: root}
+}
+
+func ValidateName(name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("profile names use up to 32 lowercase letters, digits, - and _, got %q", name)
+	}
+	return nil
+}
+
+func (m *Manager) dir() string {
+	return filepath.Join(m.root, "profiles")
+}
+
+func (m *Manager) SavePath(name string) string {
+	return filepath.Join(m.dir(), name+".json")
+}
+
+func (m *Manager) Exists(name string) bool {
+	if ValidateName(name) != nil {
+		return false
+	}
+	_, err := os.Stat(m.SavePath(name))
+	return err == nil
+}
+
+// List returns every profile name in alphabetical order.
+func (m *Manager) List() ([]string, error) {
+	files, err := os.ReadDir(m.dir())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, file := range files {
+		name, ok := strings.CutSuffix(file.Name(), ".json")
+		if ok && !file.IsDir() && ValidateName(name) == nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Create starts a profile with a new game.
+func (m *Manager) Create(name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if m.Exists(name) {
+		return fmt.Errorf("profile %s already exists", name)
+	}
+	return save.Write(m.SavePath(name), save.NewState())
+}
+
+func (m *Manager) Delete(name string) error {
+	if !m.Exists(name) {
+		return fmt.Errorf("profile %s doesn't exist", name)
+	}
+	return os.Remove(m.SavePath(name))
+}
+
+func (m *Manager) Rename(oldName, newName string) error {
+	if err := ValidateName(newName); err != nil {
+		return err
+	}
+	if !m.Exists(oldName) {
+		return fmt.Errorf("profile %s doesn't exist", oldName)
+	}
+	if m.Exists(newName) {
+		return fmt.Errorf("profile %s already exists", newName)
+	}
+	if err := os.Rename(m.SavePath(oldName), m.SavePath(newName)); err != nil {
+		return err
+	}
+
+	if active, err := m.Active(); err == nil && active == oldName {
+		return m.SetActive(newName)
+	}
+	return nil
+}
+
+// Active returns the last active profile, or the default one.
+func (m *Manager) Active() (string, error) {
+	data, err := os.ReadFile(filepath.Join(m.root, "profiles.json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return DefaultName, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var state managerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return "", err
+	}
+	if state.Active == "" {
+		return DefaultName, nil
+	}
+	return state.Active, nil
+}
+
+func (m *Manager) SetActive(name string) error {
+	if err := os.MkdirAll(m.root, 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(managerState{Active: name})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(m.root, "profiles.json"), data, 0o644)
+}
+
+// AdoptLegacy moves a save from before profiles into the default profile so
+// nobody loses their game.
+func (m *Manager) AdoptLegacy(legacyPath string) error {
+	if _, err := os.Stat(legacyPath); err != nil || m.Exists(DefaultName) {
+		return nil
+	}
+	if err := os.MkdirAll(m.dir(), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(legacyPath, m.SavePath(DefaultName))
+}
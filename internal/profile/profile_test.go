@@ -0,0 +1,126 @@
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/save"
+)
+
+func TestCreateAndList(t *testing.T) {
+	m := NewManager(t.TempDir())
+
+	names, err := m.List()
+	if err != nil || len(names) != 0 {
+		t.Fatalf("expected no profiles, got %v, %v", names, err)
+	}
+
+	for _, name := range []string{"misty", "brock"} {
+		if err := m.Create(name); err != nil {
+			t.Fatalf("expected no error creating %s, got %v", name, err)
+		}
+	}
+
+	if err := m.Create("misty"); err == nil {
+		t.Error("expected error creating a duplicate profile")
+	}
+
+	names, _ = m.List()
+	if !reflect.DeepEqual(names, []string{"brock", "misty"}) {
+		t.Errorf("expected [brock misty], got %v", names)
+	}
+
+	state, err := save.Load(m.SavePath("misty"))
+	if err != nil {
+		t.Fatalf("expected no error loading, got %v", err)
+	}
+	if state.Money != 3000 {
+		t.Errorf("expected a new profile to start a new game, got $%d", state.Money)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	for _, name := range []string{"red", "team-rocket", "ash_2"} {
+		if err := ValidateName(name); err != nil {
+			t.Errorf("expected %q to be valid, got %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"", "../etc", "a b", "Red", "this-name-is-far-too-long-for-a-profile"} {
+		if err := ValidateName(name); err == nil {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
+
+func TestRenameKeepsActive(t *testing.T) {
+	m := NewManager(t.TempDir())
+	m.Create("red")
+	m.SetActive("red")
+
+	if err := m.Rename("red", "blue"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if m.Exists("red") || !m.Exists("blue") {
+		t.Error("expected the save file to be renamed")
+	}
+
+	if active, _ := m.Active(); active != "blue" {
+		t.Errorf("expected the active profile to follow the rename, got %s", active)
+	}
+
+	if err := m.Rename("green", "yellow"); err == nil {
+		t.Error("expected error renaming a missing profile")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	m := NewManager(t.TempDir())
+	m.Create("red")
+
+	if err := m.Delete("red"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if m.Exists("red") {
+		t.Error("expected the profile to be gone")
+	}
+	if err := m.Delete("red"); err == nil {
+		t.Error("expected error deleting a missing profile")
+	}
+
+	// Names that escape the profiles directory never match a file
+	if err := m.Delete("../save"); err == nil {
+		t.Error("expected error deleting outside the profiles directory")
+	}
+}
+
+func TestActiveDefaultsToDefault(t *testing.T) {
+	m := NewManager(t.TempDir())
+
+	if active, err := m.Active(); err != nil || active != DefaultName {
+		t.Errorf("expected %s, got %s, %v", DefaultName, active, err)
+	}
+}
+
+func TestAdoptLegacy(t *testing.T) {
+	root := t.TempDir()
+	legacy := filepath.Join(root, "save.json")
+	os.WriteFile(legacy, []byte(`{"money":42}`), 0o644)
+
+	m := NewManager(root)
+	if err := m.AdoptLegacy(legacy); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	state, err := save.Load(m.SavePath(DefaultName))
+	if err != nil || state.Money != 42 {
+		t.Errorf("expected the old save to become the default profile, got %+v, %v", state, err)
+	}
+
+	if _, err := os.Stat(legacy); err == nil {
+		t.Error("expected the old save to be moved")
+	}
+}
@@ -0,0 +1,261 @@
+package quests
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// BonusPerQuest is the extra catch power each completed quest gives for
+	// the rest of its day.
+	BonusPerQuest = 0.1
+
+	dayLayout = "2006-01-02"
+)
+
+type Kind string
+
+const (
+	KindCatchType    Kind = "catch-type"
+	KindFindHeavy    Kind = "find-heavy"
+	KindExploreAreas Kind = "explore-areas"
+)
+
+// Quest is one daily objective. Type is set for catch-type quests and
+// MinWeight, in hectograms like PokeAPI weights, for find-heavy quests.
+type Quest struct {
+	Kind      Kind
+	Type      string
+	MinWeight int
+	Goal      int
+	Count     int
+}
+
+func (q Quest) Done() bool {
+	return q.Count >= q.Goal
+}
+
+func (q Quest) String() string {
+	switch q.Kind {
+	case KindCatchType:
+		if q.Goal == 1 {
+			return fmt.Sprintf("Catch a %s-type Pokemon", q.Type)
+		}
+		return fmt.Sprintf("Catch %d %s-type Pokemon", q.Goal, q.Type)
+	case KindFindHeavy:
+		return fmt.Sprintf("Find a Pokemon over %dkg", q.MinWeight/10)
+	case KindExploreAreas:
+		return fmt.Sprintf("Explore %d new areas", q.Goal)
+	}
+	return string(q.Kind)
+}
+
+// Source is the game data daily quests are drawn from.
+type Source interface {
+	// Types are the Pokemon types with the names of the Pokemon of each.
+	Types() (map[string][]string, error)
+	// Weight is how heavy a Pokemon is, in hectograms.
+	Weight(pokemon string) (int, error)
+}
+
+// heavySample is how many Pokemon a find-heavy quest weighs: it asks for one
+// heavier than all of them, and never for less than minHeavyWeight.
+const (
+	heavySample    = 3
+	minHeavyWeight = 1000
+)
+
+// Generate picks the quests for day from the types and Pokemon in src. The
+// same date always gets the same quests for the same data, so every trainer
+// shares the daily board.
+func Generate(day time.Time, src Source) ([]Quest, error) {
+	y, m, d := day.Date()
+	rng := rand.New(rand.NewSource(int64(y*10000 + int(m)*100 + d)))
+
+	types, err := src.Types()
+	if err != nil {
+		return nil, err
+	}
+	names := slices.Sorted(maps.Keys(types))
+	var pool []string
+	for _, name := range names {
+		pool = append(pool, types[name]...)
+	}
+	slices.Sort(pool)
+	pool = slices.Compact(pool)
+	if len(pool) == 0 {
+		return nil, errors.New("no Pokemon to draw quests from")
+	}
+
+	catchType := Quest{Kind: KindCatchType, Type: names[rng.Intn(len(names))], Goal: 1 + rng.Intn(3)}
+
+	// The threshold is rounded down to whole tens of kilograms
+	heaviest := 0
+	for range heavySample {
+		weight, err := src.Weight(pool[rng.Intn(len(pool))])
+		if err != nil {
+			return nil, err
+		}
+		heaviest = max(heaviest, weight)
+	}
+	findHeavy := Quest{Kind: KindFindHeavy, MinWeight: max(heaviest-heaviest%100, minHeavyWeight), Goal: 1}
+
+	return []Quest{
+		catchType,
+		findHeavy,
+		{Kind: KindExploreAreas, Goal: 3 + rng.Intn(3)},
+	}, nil
+}
+
+// Outcome is something that happened in the game and may count toward a
+// quest: a Catch, a Find or an Explore.
+type Outcome interface {
+	isOutcome()
+}
+
+type Catch struct {
+	Types []string
+}
+
+// Find is a wild Pokemon met while trying to catch or battle it.
+type Find struct {
+	Weight int
+}
+
+// Explore is a visit to Area. New is set when the trainer had never explored
+// it before, which is all an explore-areas quest counts.
+type Explore struct {
+	Area string
+	New  bool
+}
+
+func (Catch) isOutcome()   {}
+func (Find) isOutcome()    {}
+func (Explore) isOutcome() {}
+
+// Board holds the quests of one day. Drawing a new day's quests needs game
+// data, so the caller does it with Generate and Start; until then a board
+// from an earlier day has no quests and gives no bonus.
+type Board struct {
+	mu     sync.Mutex
+	day    string
+	quests []Quest
+	areas  []string
+}
+
+func NewBoard() *Board {
+	return &Board{}
+}
+
+// Restore puts back a board read from a save. A board from an earlier day
+// counts for nothing until the next Start.
+func (b *Board) Restore(day string, quests []Quest, areas []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.day = day
+	b.quests = slices.Clone(quests)
+	b.areas = slices.Clone(areas)
+}
+
+// State returns what a save needs to restore the board: its day, quests and
+// the areas explored that day.
+func (b *Board) State() (string, []Quest, []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.day, slices.Clone(b.quests), slices.Clone(b.areas)
+}
+
+// Current reports whether the board holds the quests for now's day.
+func (b *Board) Current(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.current(now)
+}
+
+func (b *Board) current(now time.Time) bool {
+	return b.day == now.Format(dayLayout) && len(b.quests) > 0
+}
+
+// Start replaces the board with quests for now's day, as drawn by Generate.
+func (b *Board) Start(now time.Time, quests []Quest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.day = now.Format(dayLayout)
+	b.quests = slices.Clone(quests)
+	b.areas = nil
+}
+
+// Quests returns today's quests, or none when they haven't been drawn yet.
+func (b *Board) Quests(now time.Time) []Quest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.current(now) {
+		return nil
+	}
+	return slices.Clone(b.quests)
+}
+
+// Record counts outcome toward today's quests and returns the ones it
+// completed.
+func (b *Board) Record(outcome Outcome, now time.Time) []Quest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.current(now) {
+		return nil
+	}
+
+	// Each area counts once, however its visits were reported
+	if o, ok := outcome.(Explore); ok {
+		if !o.New || slices.Contains(b.areas, o.Area) {
+			return nil
+		}
+		b.areas = append(b.areas, o.Area)
+	}
+
+	var completed []Quest
+	for i := range b.quests {
+		q := &b.quests[i]
+		if q.Done() || !counts(*q, outcome) {
+			continue
+		}
+		q.Count++
+		if q.Done() {
+			completed = append(completed, *q)
+		}
+	}
+	return completed
+}
+
+func counts(q Quest, outcome Outcome) bool {
+	switch o := outcome.(type) {
+	case Catch:
+		return q.Kind == KindCatchType && slices.Contains(o.Types, q.Type)
+	case Find:
+		return q.Kind == KindFindHeavy && o.Weight > q.MinWeight
+	case Explore:
+		return q.Kind == KindExploreAreas
+	}
+	return false
+}
+
+// CatchBonus is the extra catch power earned by today's completed quests.
+func (b *Board) CatchBonus(now time.Time) float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.current(now) {
+		return 0
+	}
+
+	bonus := 0.0
+	for _, q := range b.quests {
+		if q.Done() {
+			bonus += BonusPerQuest
+		}
+	}
+	return bonus
+}
@@ -0,0 +1,184 @@
+package quests
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var today = time.Date(2024, 5, 1, 9, 0, 0, 0, time.Local)
+
+// fakeData is a Source with a few types, weighing Pokemon by their names.
+type fakeData struct {
+	err error
+}
+
+var testData = fakeData{}
+
+func (f fakeData) Types() (map[string][]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return map[string][]string{
+		"electric": {"pikachu", "magnemite"},
+		"water":    {"squirtle", "wailord"},
+		"rock":     {"onix", "geodude"},
+		"fire":     {"charmander"},
+	}, nil
+}
+
+func (f fakeData) Weight(pokemon string) (int, error) {
+	weights := map[string]int{"pikachu": 60, "magnemite": 60, "squirtle": 90, "wailord": 3980, "onix": 2100, "geodude": 200, "charmander": 85}
+	weight, ok := weights[pokemon]
+	if !ok {
+		return 0, fmt.Errorf("no pokemon %s", pokemon)
+	}
+	return weight, nil
+}
+
+// lightData is a Source where every Pokemon weighs under 10kg.
+type lightData struct{}
+
+func (lightData) Types() (map[string][]string, error) {
+	return map[string][]string{"bug": {"caterpie", "weedle"}}, nil
+}
+
+func (lightData) Weight(string) (int, error) {
+	return 29, nil
+}
+
+func TestGenerateIsSeededByDate(t *testing.T) {
+	first, err := Generate(today, testData)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	again, _ := Generate(today.Add(10*time.Hour), testData)
+	if !reflect.DeepEqual(first, again) {
+		t.Errorf("expected the same quests all day, got %v and %v", first, again)
+	}
+
+	different := false
+	for d := 1; d <= 7; d++ {
+		if next, _ := Generate(today.AddDate(0, 0, d), testData); !reflect.DeepEqual(first, next) {
+			different = true
+		}
+	}
+	if !different {
+		t.Error("expected the quests to rotate over a week")
+	}
+
+	for _, q := range first {
+		if q.Goal < 1 || q.Count != 0 {
+			t.Errorf("expected a fresh quest with a goal, got %+v", q)
+		}
+	}
+}
+
+func TestGenerateDrawsFromSource(t *testing.T) {
+	types, _ := testData.Types()
+	for d := 0; d < 30; d++ {
+		board, err := Generate(today.AddDate(0, 0, d), testData)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, ok := types[board[0].Type]; !ok {
+			t.Errorf("expected a type from the source, got %s", board[0].Type)
+		}
+		if w := board[1].MinWeight; w%100 != 0 || w < minHeavyWeight || w > 3900 {
+			t.Errorf("expected a weight in tens of kilograms from 100kg up to the heaviest pokemon, got %d", w)
+		}
+	}
+
+	light := lightData{}
+	if board, _ := Generate(today, light); board[1].MinWeight != minHeavyWeight {
+		t.Errorf("expected light pokemon to still ask for over 100kg, got %d", board[1].MinWeight)
+	}
+
+	if _, err := Generate(today, fakeData{err: errors.New("offline")}); err == nil {
+		t.Error("expected an error when the source fails")
+	}
+}
+
+func TestRecord(t *testing.T) {
+	board := NewBoard()
+	board.Restore(today.Format(dayLayout), []Quest{
+		{Kind: KindCatchType, Type: "water", Goal: 2},
+		{Kind: KindFindHeavy, MinWeight: 1000, Goal: 1},
+		{Kind: KindExploreAreas, Goal: 2},
+	}, nil)
+
+	board.Record(Catch{Types: []string{"fire"}}, today)
+	if completed := board.Record(Catch{Types: []string{"water", "ground"}}, today); len(completed) != 0 {
+		t.Errorf("expected 1/2 water catches, got %v", completed)
+	}
+	if completed := board.Record(Catch{Types: []string{"water"}}, today); len(completed) != 1 {
+		t.Errorf("expected the water quest to complete, got %v", completed)
+	}
+
+	board.Record(Find{Weight: 1000}, today)
+	if completed := board.Record(Find{Weight: 1001}, today); len(completed) != 1 {
+		t.Errorf("expected a pokemon over 100kg to complete the quest, got %v", completed)
+	}
+
+	board.Record(Explore{Area: "viridian-forest-area", New: true}, today)
+	board.Record(Explore{Area: "viridian-forest-area", New: true}, today)
+	board.Record(Explore{Area: "pallet-town-area"}, today)
+	quests := board.Quests(today)
+	if quests[2].Count != 1 {
+		t.Errorf("expected only the first visit to a new area to count, got %d", quests[2].Count)
+	}
+
+	if bonus := board.CatchBonus(today); bonus < 0.19 || bonus > 0.21 {
+		t.Errorf("expected a 20%% bonus for two quests, got %f", bonus)
+	}
+}
+
+func TestBoardRollsOver(t *testing.T) {
+	board := NewBoard()
+	board.Restore("2024-04-30", []Quest{{Kind: KindExploreAreas, Goal: 1, Count: 1}}, []string{"pallet-town-area"})
+
+	// Yesterday's board counts for nothing until today's is drawn
+	if board.Current(today) {
+		t.Error("expected yesterday's board not to be current")
+	}
+	if bonus := board.CatchBonus(today); bonus != 0 {
+		t.Errorf("expected yesterday's bonus to expire, got %f", bonus)
+	}
+	if quests := board.Quests(today); quests != nil {
+		t.Errorf("expected no quests before today's are drawn, got %v", quests)
+	}
+	if completed := board.Record(Explore{Area: "viridian-forest-area", New: true}, today); completed != nil {
+		t.Errorf("expected nothing to count, got %v", completed)
+	}
+
+	expected, _ := Generate(today, testData)
+	board.Start(today, expected)
+	if quests := board.Quests(today); !board.Current(today) || !reflect.DeepEqual(quests, expected) {
+		t.Errorf("expected today's quests, got %v", quests)
+	}
+
+	day, _, areas := board.State()
+	if day != "2024-05-01" || areas != nil {
+		t.Errorf("expected a fresh board for 2024-05-01, got %s with %v", day, areas)
+	}
+}
+
+func TestQuestString(t *testing.T) {
+	tests := []struct {
+		quest    Quest
+		expected string
+	}{
+		{Quest{Kind: KindCatchType, Type: "water", Goal: 1}, "Catch a water-type Pokemon"},
+		{Quest{Kind: KindCatchType, Type: "fire", Goal: 3}, "Catch 3 fire-type Pokemon"},
+		{Quest{Kind: KindFindHeavy, MinWeight: 1000, Goal: 1}, "Find a Pokemon over 100kg"},
+		{Quest{Kind: KindExploreAreas, Goal: 5}, "Explore 5 new areas"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.quest.String(); got != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package render
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Nachsus/pokedexcli/internal/tui"
+)
+
+const columnGap = 2
+
+// VisibleWidth is how many columns s takes up on screen, not counting ANSI
+// escape sequences.
+func VisibleWidth(s string) int {
+	return utf8.RuneCountInString(tui.Strip(s))
+}
+
+// pad fills s with spaces up to width visible columns, on the left when
+// right is set.
+func pad(s string, width int, right bool) string {
+	gap := strings.Repeat(" ", max(width-VisibleWidth(s), 0))
+	if right {
+		return gap + s
+	}
+	return s + gap
+}
+
+// Table lines cells up in columns under a header.
+type Table struct {
+	Headers []string
+	// Right lists the columns that are aligned to the right, like numbers.
+	Right []int
+	rows  [][]string
+}
+
+func (t *Table) Add(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// Render lays the table out, shrinking the widest columns when it would be
+// wider than the style allows. Cut cells lose their ends.
+func (t *Table) Render(s Style) string {
+	widths := make([]int, len(t.Headers))
+	for i, header := range t.Headers {
+		widths[i] = VisibleWidth(header)
+	}
+	for _, row := range t.rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], VisibleWidth(cell))
+			}
+		}
+	}
+
+	for total(widths) > s.Width {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= 4 {
+			break
+		}
+		widths[widest]--
+	}
+
+	var sb strings.Builder
+	line := func(cells []string, header bool) {
+		out := make([]string, len(widths))
+		for i, width := range widths {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			if VisibleWidth(cell) > width {
+				cell = strings.TrimRight(tui.Fit(cell, width), " ")
+			}
+			cell = pad(cell, width, slices.Contains(t.Right, i))
+			if header {
+				cell = s.Bold(cell)
+			}
+			out[i] = cell
+		}
+		sb.WriteString(strings.TrimRight(strings.Join(out, strings.Repeat(" ", columnGap)), " "))
+		sb.WriteString("\n")
+	}
+
+	line(t.Headers, true)
+	rule := make([]string, len(widths))
+	for i, width := range widths {
+		rule[i] = strings.Repeat("-", width)
+	}
+	sb.WriteString(s.Dim(strings.Join(rule, strings.Repeat(" ", columnGap))) + "\n")
+	for _, row := range t.rows {
+		line(row, false)
+	}
+	return sb.String()
+}
+
+func total(widths []int) int {
+	sum := columnGap * max(len(widths)-1, 0)
+	for _, w := range widths {
+		sum += w
+	}
+	return sum
+}
+
+// Grid spreads items over as many columns as fit in the style's width,
+// reading down each column before moving to the next.
+func Grid(s Style, items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	cell := 0
+	for _, item := range items {
+		cell = max(cell, VisibleWidth(item))
+	}
+	columns := max((s.Width+columnGap)/(cell+columnGap), 1)
+	rows := (len(items) + columns - 1) / columns
+
+	var sb strings.Builder
+	for r := 0; r < rows; r++ {
+		var line []string
+		for c := 0; c < columns; c++ {
+			if i := c*rows + r; i < len(items) {
+				line = append(line, pad(items[i], cell, false))
+			}
+		}
+		sb.WriteString(strings.TrimRight(strings.Join(line, strings.Repeat(" ", columnGap)), " "))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
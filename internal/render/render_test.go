@@ -0,0 +1,98 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectColor(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		terminal bool
+		expected bool
+	}{
+		{"terminal", nil, true, true},
+		{"piped", nil, false, false},
+		{"no color", map[string]string{"NO_COLOR": "1"}, true, false},
+		{"dumb terminal", map[string]string{"TERM": "dumb"}, true, false},
+	}
+
+	for _, tt := range tests {
+		getenv := func(key string) string { return tt.env[key] }
+		if got := DetectColor(getenv, tt.terminal); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestPlainStyle(t *testing.T) {
+	s := Style{Width: DefaultWidth}
+
+	if got := s.Badges([]string{"fire", "flying"}); got != "[fire] [flying]" {
+		t.Errorf("expected bracketed types, got %q", got)
+	}
+	if got := s.Bar(128, 255, 10); got != "#####-----" {
+		t.Errorf("expected a half full bar, got %q", got)
+	}
+	if got := s.Bar(300, 255, 4); got != "####" {
+		t.Errorf("expected the bar to stop at full, got %q", got)
+	}
+	if got := s.Bold("name"); got != "name" {
+		t.Errorf("expected no escapes without color, got %q", got)
+	}
+}
+
+func TestColorStyle(t *testing.T) {
+	s := Style{Color: true, Width: DefaultWidth}
+
+	badge := s.Badge("water")
+	if !strings.Contains(badge, "48;5;69m water ") || !strings.HasSuffix(badge, reset) {
+		t.Errorf("expected a colored water badge, got %q", badge)
+	}
+	if VisibleWidth(badge) != len(" water ") {
+		t.Errorf("expected escapes to take no room, got width %d", VisibleWidth(badge))
+	}
+	if got := VisibleWidth(s.Bar(50, 100, 8)); got != 8 {
+		t.Errorf("expected the bar to be 8 columns, got %d", got)
+	}
+}
+
+func TestTable(t *testing.T) {
+	table := Table{Headers: []string{"#", "Name", "Lv"}, Right: []int{0, 2}}
+	table.Add("1", "pikachu", "7")
+	table.Add("12", "bulbasaur", "15")
+
+	expected := " #  Name       Lv\n" +
+		"--  ---------  --\n" +
+		" 1  pikachu     7\n" +
+		"12  bulbasaur  15\n"
+	if got := table.Render(Style{Width: DefaultWidth}); got != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, got)
+	}
+}
+
+func TestTable_Narrow(t *testing.T) {
+	table := Table{Headers: []string{"Name", "Area"}}
+	table.Add("pikachu", "viridian-forest-area")
+
+	for _, line := range strings.Split(strings.TrimSuffix(table.Render(Style{Width: 20}), "\n"), "\n") {
+		if VisibleWidth(line) > 20 {
+			t.Errorf("expected lines to fit in 20 columns, got %q", line)
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	// Columns of two with a gap of two fit three to a 10 column line
+	expected := "a  c  e\nb  d\n"
+	if got := Grid(Style{Width: 10}, items); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if got := Grid(Style{Width: 1}, items); got != "a\nb\nc\nd\ne\n" {
+		t.Errorf("expected one column when nothing fits, got %q", got)
+	}
+}
@@ -0,0 +1,103 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+const DefaultWidth = 80
+
+const reset = "\x1b[0m"
+
+// Style is how text output looks. Without Color everything is plain text,
+// so output piped to a file or another program stays readable. Width is the
+// number of columns layouts have to fit in.
+type Style struct {
+	Color bool
+	Width int
+}
+
+// DetectColor allows colors only on a terminal, and never when NO_COLOR is
+// set or the terminal is dumb.
+func DetectColor(getenv func(string) string, terminal bool) bool {
+	return terminal && getenv("NO_COLOR") == "" && getenv("TERM") != "dumb"
+}
+
+// typeColors are 256-color palette indexes close to the games' type colors.
+var typeColors = map[string]int{
+	"normal":   144,
+	"fire":     202,
+	"water":    69,
+	"electric": 220,
+	"grass":    76,
+	"ice":      117,
+	"fighting": 160,
+	"poison":   127,
+	"ground":   179,
+	"flying":   141,
+	"psychic":  205,
+	"bug":      106,
+	"rock":     136,
+	"ghost":    61,
+	"dragon":   57,
+	"dark":     95,
+	"steel":    146,
+	"fairy":    218,
+}
+
+func (s Style) paint(code, text string) string {
+	if !s.Color {
+		return text
+	}
+	return "\x1b[" + code + "m" + text + reset
+}
+
+func (s Style) Bold(text string) string {
+	return s.paint("1", text)
+}
+
+func (s Style) Dim(text string) string {
+	return s.paint("2", text)
+}
+
+// Badge shows a type on its color, or in brackets without colors.
+func (s Style) Badge(typeName string) string {
+	if !s.Color {
+		return "[" + typeName + "]"
+	}
+	background, ok := typeColors[typeName]
+	if !ok {
+		background = 244
+	}
+	return s.paint(fmt.Sprintf("1;38;5;16;48;5;%d", background), " "+typeName+" ")
+}
+
+func (s Style) Badges(types []string) string {
+	badges := make([]string, len(types))
+	for i, typeName := range types {
+		badges[i] = s.Badge(typeName)
+	}
+	return strings.Join(badges, " ")
+}
+
+// Bar is a width-column bar filled in proportion to value out of max. With
+// colors it goes from red through yellow to green as it fills.
+func (s Style) Bar(value, max, width int) string {
+	filled := 0
+	if max > 0 {
+		filled = min(value*width/max, width)
+	}
+
+	if !s.Color {
+		return strings.Repeat("#", filled) + strings.Repeat("-", width-filled)
+	}
+
+	color := "32"
+	switch {
+	case filled*3 < width:
+		color = "31"
+	case filled*3 < width*2:
+		color = "33"
+	}
+	return s.paint(color, strings.Repeat("\u2588", filled)) + s.Dim(strings.Repeat("\u2591", width-filled))
+}
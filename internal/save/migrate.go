@@ -0,0 +1,158 @@
+package save
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// CurrentVersion is the save schema this game writes.
+//
+//	1: bag contents only
+//	2: money
+//	3: caught Pokemon with their party and box slots
+//	4: seen Pokemon
+//	5: per-profile settings
+//	6: state wrapped in a versioned envelope with a checksum
+//	7: tags on caught Pokemon
+//	8: achievement progress
+//	9: the daily quest board
+//	10: sprite URLs on caught Pokemon
+const CurrentVersion = 10
+
+// envelopeVersion is the first version written inside an envelope.
+const envelopeVersion = 6
+
+type migration struct {
+	from    int
+	migrate func(state map[string]any) error
+}
+
+// migrations upgrade a save one version at a time. Each entry moves a save
+// from version from to from+1, so they must stay in order and unbroken.
+var migrations = []migration{
+	{1, addMoney},
+	{2, addCollection},
+	{3, addSeen},
+	{4, addSettings},
+	{5, unchanged},
+	{6, unchanged},
+	{7, addAchievements},
+	{8, unchanged},
+	{9, unchanged},
+}
+
+func migrate(data []byte, version int) ([]byte, error) {
+	var state map[string]any
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+
+	for _, m := range migrations {
+		if m.from < version {
+			continue
+		}
+		if err := m.migrate(state); err != nil {
+			return nil, fmt.Errorf("upgrading save from version %d: %w", m.from, err)
+		}
+	}
+	return json.Marshal(state)
+}
+
+// unchanged upgrades versions that only changed the envelope or added
+// optional fields.
+func unchanged(map[string]any) error {
+	return nil
+}
+
+// addMoney gives saves from before the wallet the money a new game starts
+// with.
+func addMoney(state map[string]any) error {
+	if _, ok := state["money"]; !ok {
+		state["money"] = NewState().Money
+	}
+	return nil
+}
+
+func addCollection(state map[string]any) error {
+	for _, field := range []string{"pokemon", "party", "boxes"} {
+		if state[field] == nil {
+			state[field] = []any{}
+		}
+	}
+	return nil
+}
+
+// addSeen counts every caught Pokemon as seen where and when it was caught.
+func addSeen(state map[string]any) error {
+	seen := []any{}
+	names := make(map[string]bool)
+
+	pokemon, _ := state["pokemon"].([]any)
+	for _, p := range pokemon {
+		fields, ok := p.(map[string]any)
+		if !ok {
+			return fmt.Errorf("pokemon entry is %T, not an object", p)
+		}
+		name, _ := fields["name"].(string)
+		if name == "" || names[name] {
+			continue
+		}
+		names[name] = true
+
+		sighting := map[string]any{"name": name, "first_seen": fields["caught_at"]}
+		if area, ok := fields["area"]; ok {
+			sighting["area"] = area
+		}
+		seen = append(seen, sighting)
+	}
+
+	state["seen"] = seen
+	return nil
+}
+
+func addSettings(state map[string]any) error {
+	if state["settings"] == nil {
+		state["settings"] = map[string]any{}
+	}
+	return nil
+}
+
+// addAchievements counts every Pokemon already caught toward achievements.
+// The milestones it reaches unlock when the save is next played.
+func addAchievements(state map[string]any) error {
+	catches := 0
+	types := map[string]int{}
+	species := []string{}
+	names := make(map[string]bool)
+
+	pokemon, _ := state["pokemon"].([]any)
+	for _, p := range pokemon {
+		fields, ok := p.(map[string]any)
+		if !ok {
+			return fmt.Errorf("pokemon entry is %T, not an object", p)
+		}
+		catches++
+
+		kinds, _ := fields["types"].([]any)
+		for _, kind := range kinds {
+			if name, ok := kind.(string); ok && name != "" {
+				types[name]++
+			}
+		}
+		if name, _ := fields["name"].(string); name != "" && !names[name] {
+			names[name] = true
+			species = append(species, name)
+		}
+	}
+	slices.Sort(species)
+
+	state["achievements"] = map[string]any{
+		"unlocked": map[string]any{},
+		"catches":  catches,
+		"types":    types,
+		"species":  species,
+		"areas":    []string{},
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package save
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// TestGoldenMigrations loads a save from every historical version and compares
+// the upgraded state with testdata/vN.golden.json.
+func TestGoldenMigrations(t *testing.T) {
+	for version := 1; version <= CurrentVersion; version++ {
+		input := filepath.Join("testdata", fmt.Sprintf("v%d.json", version))
+		golden := filepath.Join("testdata", fmt.Sprintf("v%d.golden.json", version))
+
+		data, err := os.ReadFile(input)
+		if err != nil {
+			t.Fatalf("v%d: missing historical save: %v", version, err)
+		}
+
+		state, detected, err := Decode(data)
+		if err != nil {
+			t.Fatalf("v%d: expected no error, got %v", version, err)
+		}
+		if detected != version {
+			t.Errorf("v%d: detected version %d", version, detected)
+		}
+
+		got, err := json.MarshalIndent(state, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, '\n')
+
+		if *update {
+			if err := os.WriteFile(golden, got, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("v%d: missing golden file, run go test -update: %v", version, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("v%d: upgraded save differs from %s\ngot:\n%s", version, golden, got)
+		}
+	}
+}
+
+func TestMigrationsAreInOrder(t *testing.T) {
+	if len(migrations) != CurrentVersion-1 {
+		t.Fatalf("expected %d migrations, got %d", CurrentVersion-1, len(migrations))
+	}
+	for i, m := range migrations {
+		if m.from != i+1 {
+			t.Errorf("migration %d upgrades from version %d, expected %d", i, m.from, i+1)
+		}
+	}
+}
+
+func TestLoad_BacksUpBeforeMigrating(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	original, _ := os.ReadFile(filepath.Join("testdata", "v2.json"))
+	os.WriteFile(path, original, 0o644)
+
+	state, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if state.Money != 1500 {
+		t.Errorf("expected money to survive the upgrade, got %d", state.Money)
+	}
+
+	backup, err := os.ReadFile(BackupPath(path, 2))
+	if err != nil {
+		t.Fatalf("expected a backup of the old save, got %v", err)
+	}
+	if !bytes.Equal(backup, original) {
+		t.Error("expected the backup to hold the original save")
+	}
+
+	upgraded, _ := os.ReadFile(path)
+	if _, version, _ := unwrap(upgraded); version != CurrentVersion {
+		t.Errorf("expected the save to be rewritten as version %d, got %d", CurrentVersion, version)
+	}
+}
+
+func TestLoad_CurrentVersionHasNoBackup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	if err := Write(path, NewState()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(path); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	matches, _ := filepath.Glob(path + ".v*.bak")
+	if len(matches) != 0 {
+		t.Errorf("expected no backup, got %v", matches)
+	}
+}
+
+func TestDecode_NewerVersion(t *testing.T) {
+	data := fmt.Sprintf(`{"version":%d,"checksum":"","data":{}}`, CurrentVersion+1)
+	if _, _, err := Decode([]byte(data)); err == nil {
+		t.Error("expected error for a save from a newer version")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.json")
+	data, _ := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("v%d.json", CurrentVersion)))
+	os.WriteFile(good, data, 0o644)
+
+	problems, err := Verify(good)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(problems) != 0 {
+		t.Errorf("expected a clean save, got %v", problems)
+	}
+
+	tampered := filepath.Join(dir, "tampered.json")
+	os.WriteFile(tampered, bytes.Replace(data, []byte(`"money": 4321`), []byte(`"money": 999999`), 1), 0o644)
+
+	problems, _ = Verify(tampered)
+	if len(problems) != 1 || !strings.Contains(problems[0], "checksum") {
+		t.Errorf("expected a checksum mismatch, got %v", problems)
+	}
+
+	enveloped := filepath.Join(dir, "enveloped.json")
+	previous, _ := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("v%d.json", CurrentVersion-1)))
+	os.WriteFile(enveloped, previous, 0o644)
+
+	problems, _ = Verify(enveloped)
+	if len(problems) != 1 || !strings.Contains(problems[0], fmt.Sprintf("version %d", CurrentVersion-1)) {
+		t.Errorf("expected only a note about the old version, got %v", problems)
+	}
+
+	old := filepath.Join(dir, "old.json")
+	legacy, _ := os.ReadFile(filepath.Join("testdata", "v3.json"))
+	os.WriteFile(old, legacy, 0o644)
+
+	problems, _ = Verify(old)
+	if len(problems) != 1 || !strings.Contains(problems[0], "version 3") {
+		t.Errorf("expected a note about the old version, got %v", problems)
+	}
+}
+
+func TestCheckState(t *testing.T) {
+	state := NewState()
+	state.Money = -5
+	state.Pokemon = []Pokemon{validPokemon(), validPokemon()}
+	state.Party = []int{1, 2}
+	state.Boxes = [][]int{{1}}
+	state.Achievements = &Achievements{Escapes: -1}
+
+	problems := checkState(state)
+
+	expected := []string{"money is negative", "achievement progress is negative", "appears more than once", "missing pokemon #2", "stored twice"}
+	for _, want := range expected {
+		found := false
+		for _, problem := range problems {
+			found = found || strings.Contains(problem, want)
+		}
+		if !found {
+			t.Errorf("expected a problem mentioning %q, got %v", want, problems)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package save
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/experience"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+// Pokemon is the saved form of a caught Pokemon. It keeps its own copy of
+// the species data so a save loads without talking to PokeAPI.
+type Pokemon struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	Species        string         `json:"species"`
+	BaseExperience int            `json:"base_experience"`
+	Height         int            `json:"height"`
+	Weight         int            `json:"weight"`
+	BaseStats      map[string]int `json:"base_stats"`
+	Types          []string       `json:"types"`
+	Moves          []Move         `json:"moves"`
+	Abilities      []string       `json:"abilities"`
+	Sprite         string         `json:"sprite,omitempty"`
+	ShinySprite    string         `json:"shiny_sprite,omitempty"`
+	Level          int            `json:"level"`
+	Experience     int            `json:"experience"`
+	GrowthRate     string         `json:"growth_rate,omitempty"`
+	IVs            map[string]int `json:"ivs"`
+	EVs            map[string]int `json:"evs"`
+	Nature         string         `json:"nature"`
+	Gender         string         `json:"gender"`
+	Shiny          bool           `json:"shiny"`
+	Nickname       string         `json:"nickname,omitempty"`
+	Tags           []string       `json:"tags,omitempty"`
+	CaughtAt       time.Time      `json:"caught_at"`
+	Area           string         `json:"area,omitempty"`
+	Region         string         `json:"region,omitempty"`
+	Ball           string         `json:"ball"`
+}
+
+type Move struct {
+	Name  string `json:"name"`
+	Level int    `json:"level"`
+}
+
+// FromEntry converts a caught Pokemon to its saved form.
+func FromEntry(entry pokedex.Entry) Pokemon {
+	moves := make([]Move, 0, len(entry.Pokemon.Moves))
+	for _, move := range entry.Pokemon.Moves {
+		moves = append(moves, Move{Name: move.Name, Level: move.Level})
+	}
+
+	return Pokemon{
+		ID:             entry.ID,
+		Name:           entry.Pokemon.Name,
+		Species:        entry.Pokemon.Species,
+		BaseExperience: entry.Pokemon.BaseExperience,
+		Height:         entry.Pokemon.Height,
+		Weight:         entry.Pokemon.Weight,
+		BaseStats:      entry.Pokemon.Stats,
+		Types:          entry.Pokemon.Types,
+		Moves:          moves,
+		Abilities:      entry.Pokemon.Abilities,
+		Sprite:         entry.Pokemon.Sprite,
+		ShinySprite:    entry.Pokemon.ShinySprite,
+		Level:          entry.Level,
+		Experience:     entry.Experience,
+		GrowthRate:     entry.GrowthRate,
+		IVs:            entry.IVs,
+		EVs:            entry.EVs,
+		Nature:         entry.Nature,
+		Gender:         entry.Gender,
+		Shiny:          entry.Shiny,
+		Nickname:       entry.Nickname,
+		Tags:           entry.Tags,
+		CaughtAt:       entry.CaughtAt,
+		Area:           entry.Area,
+		Region:         entry.Region,
+		Ball:           entry.Ball,
+	}
+}
+
+func (saved Pokemon) Entry() pokedex.Entry {
+	moves := make([]pokeapi.LearnedMove, 0, len(saved.Moves))
+	for _, move := range saved.Moves {
+		moves = append(moves, pokeapi.LearnedMove{Name: move.Name, Level: move.Level})
+	}
+
+	return pokedex.Entry{
+		ID: saved.ID,
+		Pokemon: pokeapi.PokemonDetails{
+			Name:           saved.Name,
+			BaseExperience: saved.BaseExperience,
+			Height:         saved.Height,
+			Weight:         saved.Weight,
+			Species:        saved.Species,
+			Stats:          saved.BaseStats,
+			Types:          saved.Types,
+			Moves:          moves,
+			Abilities:      saved.Abilities,
+			Sprite:         saved.Sprite,
+			ShinySprite:    saved.ShinySprite,
+		},
+		Level:      saved.Level,
+		Experience: saved.Experience,
+		GrowthRate: saved.GrowthRate,
+		IVs:        saved.IVs,
+		EVs:        saved.EVs,
+		Nature:     saved.Nature,
+		Gender:     saved.Gender,
+		Shiny:      saved.Shiny,
+		Nickname:   saved.Nickname,
+		Tags:       saved.Tags,
+		CaughtAt:   saved.CaughtAt,
+		Area:       saved.Area,
+		Region:     saved.Region,
+		Ball:       saved.Ball,
+	}
+}
+
+// Validate checks that a saved Pokemon describes something the game could
+// have produced, so hand edited files fail loudly instead of loading garbage.
+func (saved Pokemon) Validate() error {
+	if saved.ID <= 0 {
+		return fmt.Errorf("invalid id %d", saved.ID)
+	}
+	if saved.Name == "" {
+		return errors.New("missing name")
+	}
+	if saved.Level < 1 || saved.Level > experience.MaxLevel {
+		return fmt.Errorf("%s: level must be between 1 and %d, got %d", saved.Name, experience.MaxLevel, saved.Level)
+	}
+	if len(saved.Types) == 0 {
+		return fmt.Errorf("%s: missing types", saved.Name)
+	}
+	if _, ok := stats.GetNature(saved.Nature); !ok {
+		return fmt.Errorf("%s: unknown nature %q", saved.Name, saved.Nature)
+	}
+	switch saved.Gender {
+	case "male", "female", "genderless":
+	default:
+		return fmt.Errorf("%s: unknown gender %q", saved.Name, saved.Gender)
+	}
+
+	for _, tag := range saved.Tags {
+		if err := pokedex.ValidateTag(tag); err != nil {
+			return fmt.Errorf("%s: %w", saved.Name, err)
+		}
+	}
+
+	for _, name := range stats.Names {
+		if _, ok := saved.BaseStats[name]; !ok {
+			return fmt.Errorf("%s: missing base %s", saved.Name, name)
+		}
+		if iv := saved.IVs[name]; iv < 0 || iv > stats.MaxIV {
+			return fmt.Errorf("%s: %s IV must be between 0 and %d, got %d", saved.Name, name, stats.MaxIV, iv)
+		}
+		if ev := saved.EVs[name]; ev < 0 || ev > stats.MaxEV {
+			return fmt.Errorf("%s: %s EV must be between 0 and %d, got %d", saved.Name, name, stats.MaxEV, ev)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package save
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+func validPokemon() Pokemon {
+	base := make(map[string]int, len(stats.Names))
+	ivs := make(map[string]int, len(stats.Names))
+	evs := make(map[string]int, len(stats.Names))
+	for _, name := range stats.Names {
+		base[name], ivs[name], evs[name] = 50, 15, 0
+	}
+
+	return Pokemon{
+		ID:        1,
+		Name:      "pikachu",
+		Species:   "pikachu",
+		BaseStats: base,
+		Types:     []string{"electric"},
+		Moves:     []Move{},
+		Level:     5,
+		IVs:       ivs,
+		EVs:       evs,
+		Nature:    "hardy",
+		Gender:    "male",
+		CaughtAt:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Ball:      "poke-ball",
+	}
+}
+
+func TestEntryRoundTrip(t *testing.T) {
+	entry := pokedex.Entry{
+		ID: 7,
+		Pokemon: pokeapi.PokemonDetails{
+			Name:      "pikachu",
+			Species:   "pikachu",
+			Stats:     map[string]int{"hp": 35},
+			Types:     []string{"electric"},
+			Moves:     []pokeapi.LearnedMove{{Name: "thunder-shock", Level: 1}},
+			Abilities: []string{"static"},
+			Sprite:    "https://sprites/25.png",
+		},
+		Level:    12,
+		IVs:      map[string]int{"hp": 31},
+		Nature:   "timid",
+		Nickname: "sparky",
+		Tags:     []string{"favorite"},
+		CaughtAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	if got := FromEntry(entry).Entry(); !reflect.DeepEqual(got, entry) {
+		t.Errorf("expected %+v, got %+v", entry, got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := validPokemon().Validate(); err != nil {
+		t.Fatalf("expected a valid pokemon, got %v", err)
+	}
+
+	tests := map[string]func(p *Pokemon){
+		"id":     func(p *Pokemon) { p.ID = 0 },
+		"name":   func(p *Pokemon) { p.Name = "" },
+		"level":  func(p *Pokemon) { p.Level = 101 },
+		"types":  func(p *Pokemon) { p.Types = nil },
+		"nature": func(p *Pokemon) { p.Nature = "grumpy" },
+		"gender": func(p *Pokemon) { p.Gender = "unknown" },
+		"base":   func(p *Pokemon) { delete(p.BaseStats, "speed") },
+		"iv":     func(p *Pokemon) { p.IVs["hp"] = 32 },
+		"ev":     func(p *Pokemon) { p.EVs["attack"] = -1 },
+		"tag":    func(p *Pokemon) { p.Tags = []string{"Not A Tag"} },
+	}
+
+	for name, breakIt := range tests {
+		p := validPokemon()
+		breakIt(&p)
+		if err := p.Validate(); err == nil {
+			t.Errorf("%s: expected a validation error", name)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package save
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type State struct {
+	Money     int            `json:"money"`
+	Inventory map[string]int `json:"inventory"`
+	Pokemon   []Pokemon      `json:"pokemon"`
+	Party     []int          `json:"party"`
+	Boxes     [][]int        `json:"boxes"`
+	Seen      []Sighting     `json:"seen"`
+	Settings  Settings       `json:"settings"`
+
+	// Achievements is nil for a new game.
+	Achievements *Achievements `json:"achievements,omitempty"`
+	Quests       *Quests       `json:"quests,omitempty"`
+}
+
+// Settings are the per-profile preferences.
+type Settings struct {
+	CatchMode string `json:"catch_mode,omitempty"`
+}
+
+type Achievements struct {
+	Unlocked  map[string]time.Time `json:"unlocked"`
+	Catches   int                  `json:"catches"`
+	Escapes   int                  `json:"escapes"`
+	Legendary int                  `json:"legendary"`
+	Types     map[string]int       `json:"types"`
+	Species   []string             `json:"species"`
+	Areas     []string             `json:"areas"`
+}
+
+// Quests is the daily quest board as it was on Day.
+type Quests struct {
+	Day    string   `json:"day"`
+	Quests []Quest  `json:"quests"`
+	Areas  []string `json:"areas,omitempty"`
+}
+
+type Quest struct {
+	Kind      string `json:"kind"`
+	Type      string `json:"type,omitempty"`
+	MinWeight int    `json:"min_weight,omitempty"`
+	Goal      int    `json:"goal"`
+	Count     int    `json:"count"`
+}
+
+type Sighting struct {
+	Name      string    `json:"name"`
+	Area      string    `json:"area,omitempty"`
+	FirstSeen time.Time `json:"first_seen"`
+}
+
+func NewState() *State {
+	return &State{
+		Money: 3000,
+		Inventory: map[string]int{
+			"poke-ball": 10,
+		},
+	}
+}
+
+// DefaultDir is where the game keeps its files.
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".pokedexcli"), nil
+}
+
+// DefaultPath is the single save file used before trainer profiles.
+func DefaultPath() (string, error) {
+	dir, err := DefaultDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "save.json"), nil
+}
+
+// envelope wraps the game state with its schema version and a checksum of
+// the compact JSON of Data.
+type envelope struct {
+	Version  int             `json:"version"`
+	Checksum string          `json:"checksum"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// Load reads the save at path, starting a fresh game when none exists yet.
+// Saves from older versions are upgraded, after the original is copied next
+// to it as a backup.
+func Load(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewState(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state, version, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if version < CurrentVersion {
+		if err := os.WriteFile(BackupPath(path, version), data, 0o644); err != nil {
+			return nil, err
+		}
+		if err := Write(path, state); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+func BackupPath(path string, version int) string {
+	return fmt.Sprintf("%s.v%d.bak", path, version)
+}
+
+// Decode reads a save of any version, migrating it to the current one. It
+// also returns the version the save was written in.
+func Decode(data []byte) (*State, int, error) {
+	raw, version, err := unwrap(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version > CurrentVersion {
+		return nil, 0, fmt.Errorf("save is version %d, this game only knows up to version %d", version, CurrentVersion)
+	}
+
+	if version < CurrentVersion {
+		if raw, err = migrate(raw, version); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	var state State
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, 0, err
+	}
+	if state.Inventory == nil {
+		state.Inventory = make(map[string]int)
+	}
+	return &state, version, nil
+}
+
+// unwrap returns the game state inside a save and its version. Saves from
+// before the envelope are bare state, and their version is worked out from
+// the fields they have.
+func unwrap(data []byte) (json.RawMessage, int, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, 0, err
+	}
+
+	if _, ok := fields["data"]; ok {
+		var env envelope
+		if err := json.Unmarshal(data, &env); err != nil {
+			return nil, 0, err
+		}
+		if env.Version < 1 {
+			return nil, 0, fmt.Errorf("invalid save version %d", env.Version)
+		}
+		return env.Data, env.Version, nil
+	}
+
+	version := 1
+	for v, field := range map[int]string{2: "money", 3: "pokemon", 4: "seen", 5: "settings"} {
+		if _, ok := fields[field]; ok && v > version {
+			version = v
+		}
+	}
+	return data, version, nil
+}
+
+// Write replaces the save at path via a temporary file so an interrupted
+// write never leaves a truncated save behind.
+func Write(path string, state *State) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(envelope{
+		Version:  CurrentVersion,
+		Checksum: checksum(payload),
+		Data:     payload,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func checksum(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,128 @@
+package save
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoad_MissingFileStartsFresh(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+
+	state, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if state.Inventory["poke-ball"] != 10 {
+		t.Errorf("expected starter poke-balls, got %d", state.Inventory["poke-ball"])
+	}
+
+	if state.Money != 3000 {
+		t.Errorf("expected starter money 3000, got %d", state.Money)
+	}
+}
+
+func TestWriteAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "save.json")
+
+	state := &State{
+		Money:     1234,
+		Inventory: map[string]int{"potion": 3, "great-ball": 1},
+	}
+
+	if err := Write(path, state); err != nil {
+		t.Fatalf("expected no error writing, got %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected no error loading, got %v", err)
+	}
+
+	if loaded.Inventory["potion"] != 3 || loaded.Inventory["great-ball"] != 1 {
+		t.Errorf("expected inventory to round trip, got %v", loaded.Inventory)
+	}
+
+	if loaded.Money != 1234 {
+		t.Errorf("expected money to round trip, got %d", loaded.Money)
+	}
+
+	if _, err := os.Stat(path + ".tmp"); err == nil {
+		t.Error("expected temporary file to be renamed away")
+	}
+}
+
+func TestWriteAndLoad_Pokemon(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+
+	caughtAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	state := NewState()
+	state.Pokemon = []Pokemon{
+		{
+			ID:        3,
+			Name:      "pikachu",
+			Species:   "pikachu",
+			BaseStats: map[string]int{"hp": 35},
+			Types:     []string{"electric"},
+			Moves:     []Move{{Name: "thunder-shock", Level: 1}},
+			Level:     12,
+			IVs:       map[string]int{"hp": 31},
+			Nature:    "timid",
+			Nickname:  "sparky",
+			CaughtAt:  caughtAt,
+			Ball:      "great-ball",
+		},
+	}
+	state.Party = []int{3}
+	state.Boxes = [][]int{{}, {5, 6}}
+	state.Seen = []Sighting{{Name: "zubat", Area: "mt-moon-1f", FirstSeen: caughtAt}}
+
+	if err := Write(path, state); err != nil {
+		t.Fatalf("expected no error writing, got %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected no error loading, got %v", err)
+	}
+
+	if len(loaded.Pokemon) != 1 {
+		t.Fatalf("expected 1 pokemon, got %d", len(loaded.Pokemon))
+	}
+
+	got := loaded.Pokemon[0]
+	if got.ID != 3 || got.Nickname != "sparky" || got.Level != 12 || got.IVs["hp"] != 31 {
+		t.Errorf("expected pokemon to round trip, got %+v", got)
+	}
+
+	if !got.CaughtAt.Equal(caughtAt) {
+		t.Errorf("expected catch time %v, got %v", caughtAt, got.CaughtAt)
+	}
+
+	if len(got.Moves) != 1 || got.Moves[0].Name != "thunder-shock" {
+		t.Errorf("expected learnset to round trip, got %v", got.Moves)
+	}
+
+	if len(loaded.Party) != 1 || loaded.Party[0] != 3 {
+		t.Errorf("expected party [3], got %v", loaded.Party)
+	}
+
+	if len(loaded.Boxes) != 2 || len(loaded.Boxes[1]) != 2 {
+		t.Errorf("expected boxes to round trip, got %v", loaded.Boxes)
+	}
+
+	if len(loaded.Seen) != 1 || loaded.Seen[0].Area != "mt-moon-1f" {
+		t.Errorf("expected sightings to round trip, got %v", loaded.Seen)
+	}
+}
+
+func TestLoad_InvalidJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	os.WriteFile(path, []byte("invalid json"), 0o644)
+
+	if _, err := Load(path); err == nil {
+		t.Error("expected error for invalid save file")
+	}
+}
@@ -0,0 +1,103 @@
+package save
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+)
+
+// Verify checks the integrity of the save at path and describes every
+// problem it finds. An error means the file couldn't be checked at all.
+func Verify(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, version, err := unwrap(data)
+	if err != nil {
+		return []string{fmt.Sprintf("save is not valid JSON: %s", err)}, nil
+	}
+
+	var problems []string
+	if version < envelopeVersion {
+		problems = append(problems, fmt.Sprintf("save is version %d and has no checksum, it is upgraded the next time it loads", version))
+	} else {
+		var env envelope
+		json.Unmarshal(data, &env)
+
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, raw); err != nil {
+			return append(problems, fmt.Sprintf("save data is not valid JSON: %s", err)), nil
+		}
+		if checksum(compact.Bytes()) != env.Checksum {
+			problems = append(problems, "checksum mismatch, the save was changed outside the game or is corrupted")
+		}
+		if version < CurrentVersion {
+			problems = append(problems, fmt.Sprintf("save is version %d, it is upgraded the next time it loads", version))
+		}
+	}
+
+	state, _, err := Decode(data)
+	if err != nil {
+		return append(problems, fmt.Sprintf("save can't be read: %s", err)), nil
+	}
+	return append(problems, checkState(state)...), nil
+}
+
+// checkState looks for contents the game would never write, such as
+// impossible stats or a Pokemon kept in two places.
+func checkState(state *State) []string {
+	var problems []string
+	if state.Money < 0 {
+		problems = append(problems, fmt.Sprintf("money is negative: %d", state.Money))
+	}
+	for name, quantity := range state.Inventory {
+		if quantity < 0 {
+			problems = append(problems, fmt.Sprintf("bag holds %d %s", quantity, name))
+		}
+	}
+
+	if a := state.Achievements; a != nil && (a.Catches < 0 || a.Escapes < 0 || a.Legendary < 0) {
+		problems = append(problems, "achievement progress is negative")
+	}
+
+	ids := make(map[int]bool, len(state.Pokemon))
+	for _, p := range state.Pokemon {
+		if err := p.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("pokemon #%d: %s", p.ID, err))
+		}
+		if ids[p.ID] {
+			problems = append(problems, fmt.Sprintf("pokemon #%d appears more than once", p.ID))
+		}
+		ids[p.ID] = true
+	}
+
+	if len(state.Party) > pokedex.PartySize {
+		problems = append(problems, fmt.Sprintf("party holds %d pokemon", len(state.Party)))
+	}
+	if len(state.Boxes) > pokedex.BoxCount {
+		problems = append(problems, fmt.Sprintf("save has %d boxes", len(state.Boxes)))
+	}
+
+	stored := make(map[int]bool)
+	places := append([][]int{state.Party}, state.Boxes...)
+	for i, place := range places {
+		if i > 0 && len(place) > pokedex.BoxSize {
+			problems = append(problems, fmt.Sprintf("box %d holds %d pokemon", i, len(place)))
+		}
+		for _, id := range place {
+			if !ids[id] {
+				problems = append(problems, fmt.Sprintf("storage refers to missing pokemon #%d", id))
+			}
+			if stored[id] {
+				problems = append(problems, fmt.Sprintf("pokemon #%d is stored twice", id))
+			}
+			stored[id] = true
+		}
+	}
+	return problems
+}
@@ -0,0 +1,402 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/save"
+)
+
+var (
+	// ErrNotFound means the Pokemon or area asked for doesn't exist.
+	ErrNotFound = errors.New("not found")
+	// ErrRejected means the game refused an action, like catching without
+	// any balls left.
+	ErrRejected = errors.New("rejected")
+)
+
+// Reject marks err as the game refusing an action. The message stays the
+// same.
+func Reject(err error) error {
+	return rejectedError{err}
+}
+
+type rejectedError struct {
+	err error
+}
+
+func (e rejectedError) Error() string {
+	return e.err.Error()
+}
+
+func (e rejectedError) Is(target error) bool {
+	return target == ErrRejected
+}
+
+// Game is the running game the server exposes. Actions save the game when
+// they succeed and return what the game printed while doing them.
+type Game interface {
+	Entries() []pokedex.Entry
+	Release(id int) (pokedex.Entry, error)
+	Explore(area string) ([]pokeapi.Encounter, []string, error)
+	// Catch returns the new entry, or nil when the Pokemon got away.
+	Catch(pokemon, ball, nickname string) (*pokedex.Entry, []string, error)
+}
+
+// PokeAPI is the cached PokeAPI client the server proxies lookups through.
+type PokeAPI interface {
+	Pokemon(name string) (*pokeapi.PokemonDetails, error)
+	Species(name string) (*pokeapi.PokemonSpecies, error)
+	Area(name string) ([]pokeapi.Encounter, error)
+	// Areas lists a page of areas starting at offset.
+	Areas(offset int) ([]string, error)
+}
+
+// Server serves the game as a JSON API. The game isn't safe for concurrent
+// use, so requests that touch it take turns.
+type Server struct {
+	game Game
+	api  PokeAPI
+	mu   sync.Mutex
+	mux  *http.ServeMux
+}
+
+func New(game Game, api PokeAPI) *Server {
+	s := &Server{game: game, api: api, mux: http.NewServeMux()}
+	s.mux.HandleFunc("GET /api/pokedex", s.listPokedex)
+	s.mux.HandleFunc("GET /api/pokedex/{id}", s.getPokemon)
+	s.mux.HandleFunc("DELETE /api/pokedex/{id}", s.releasePokemon)
+	s.mux.HandleFunc("POST /api/explore", s.explore)
+	s.mux.HandleFunc("POST /api/catch", s.catch)
+	s.mux.HandleFunc("GET /api/pokeapi/pokemon/{name}", s.lookupPokemon)
+	s.mux.HandleFunc("GET /api/pokeapi/species/{name}", s.lookupSpecies)
+	s.mux.HandleFunc("GET /api/pokeapi/areas", s.listAreas)
+	s.mux.HandleFunc("GET /api/pokeapi/areas/{name}", s.lookupArea)
+	return s
+}
+
+// ServeHTTP answers requests no route matches in JSON too, telling a wrong
+// method apart from a wrong path.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if _, pattern := s.mux.Handler(r); pattern != "" {
+		s.mux.ServeHTTP(w, r)
+		return
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodDelete} {
+		probe := r.Clone(r.Context())
+		probe.Method = method
+		if _, pattern := s.mux.Handler(probe); pattern != "" {
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, errors.New("no such endpoint"))
+}
+
+type pokedexResponse struct {
+	Count   int            `json:"count"`
+	Pokemon []save.Pokemon `json:"pokemon"`
+}
+
+type encounter struct {
+	Name     string `json:"name"`
+	MinLevel int    `json:"min_level"`
+	MaxLevel int    `json:"max_level"`
+}
+
+type areasResponse struct {
+	Offset int      `json:"offset"`
+	Areas  []string `json:"areas"`
+}
+
+type exploreRequest struct {
+	Area string `json:"area"`
+}
+
+type exploreResponse struct {
+	Area       string      `json:"area"`
+	Encounters []encounter `json:"encounters"`
+	Messages   []string    `json:"messages"`
+}
+
+type catchRequest struct {
+	Pokemon  string `json:"pokemon"`
+	Ball     string `json:"ball,omitempty"`
+	Nickname string `json:"nickname,omitempty"`
+}
+
+type catchResponse struct {
+	Caught   bool          `json:"caught"`
+	Pokemon  *save.Pokemon `json:"pokemon,omitempty"`
+	Messages []string      `json:"messages"`
+}
+
+type pokemonInfo struct {
+	Name           string         `json:"name"`
+	Species        string         `json:"species"`
+	BaseExperience int            `json:"base_experience"`
+	Height         int            `json:"height"`
+	Weight         int            `json:"weight"`
+	Stats          map[string]int `json:"stats"`
+	Types          []string       `json:"types"`
+	Abilities      []string       `json:"abilities"`
+	Sprite         string         `json:"sprite,omitempty"`
+}
+
+type speciesInfo struct {
+	Name        string `json:"name"`
+	CaptureRate int    `json:"capture_rate"`
+	GenderRate  int    `json:"gender_rate"`
+	Legendary   bool   `json:"legendary"`
+	Mythical    bool   `json:"mythical"`
+	GrowthRate  string `json:"growth_rate"`
+	Region      string `json:"region"`
+}
+
+// listPokedex filters with the same query language as the pokedex command,
+// passed as q, e.g. /api/pokedex?q=type:fire+sort:-level
+func (s *Server) listPokedex(w http.ResponseWriter, r *http.Request) {
+	query, err := pokedex.ParseQuery(strings.Fields(strings.ToLower(r.URL.Query().Get("q"))))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.mu.Lock()
+	entries := query.Apply(s.game.Entries())
+	s.mu.Unlock()
+
+	response := pokedexResponse{Count: len(entries), Pokemon: make([]save.Pokemon, 0, len(entries))}
+	for _, entry := range entries {
+		response.Pokemon = append(response.Pokemon, save.FromEntry(entry))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) getPokemon(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	entries := s.game.Entries()
+	s.mu.Unlock()
+
+	for _, entry := range entries {
+		if entry.ID == id {
+			writeJSON(w, http.StatusOK, save.FromEntry(entry))
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, ErrNotFound)
+}
+
+func (s *Server) releasePokemon(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	entry, err := s.game.Release(id)
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, save.FromEntry(entry))
+}
+
+func (s *Server) explore(w http.ResponseWriter, r *http.Request) {
+	var request exploreRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if request.Area == "" {
+		writeError(w, http.StatusBadRequest, errors.New("area is required"))
+		return
+	}
+
+	s.mu.Lock()
+	encounters, messages, err := s.game.Explore(request.Area)
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, exploreResponse{Area: request.Area, Encounters: encounterList(encounters), Messages: messages})
+}
+
+// catch answers 201 Created when the Pokemon was caught and 200 OK when it
+// got away, since a throw is spent either way.
+func (s *Server) catch(w http.ResponseWriter, r *http.Request) {
+	var request catchRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if request.Pokemon == "" {
+		writeError(w, http.StatusBadRequest, errors.New("pokemon is required"))
+		return
+	}
+	if err := pokedex.ValidateNickname(request.Nickname); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if request.Ball == "" {
+		request.Ball = "poke-ball"
+	}
+
+	s.mu.Lock()
+	entry, messages, err := s.game.Catch(request.Pokemon, request.Ball, request.Nickname)
+	s.mu.Unlock()
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	if entry == nil {
+		writeJSON(w, http.StatusOK, catchResponse{Messages: messages})
+		return
+	}
+	saved := save.FromEntry(*entry)
+	writeJSON(w, http.StatusCreated, catchResponse{Caught: true, Pokemon: &saved, Messages: messages})
+}
+
+func (s *Server) lookupPokemon(w http.ResponseWriter, r *http.Request) {
+	pokemon, err := s.api.Pokemon(r.PathValue("name"))
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, pokemonInfo{
+		Name:           pokemon.Name,
+		Species:        pokemon.SpeciesName(),
+		BaseExperience: pokemon.BaseExperience,
+		Height:         pokemon.Height,
+		Weight:         pokemon.Weight,
+		Stats:          pokemon.Stats,
+		Types:          pokemon.Types,
+		Abilities:      pokemon.Abilities,
+		Sprite:         pokemon.Sprite,
+	})
+}
+
+func (s *Server) lookupSpecies(w http.ResponseWriter, r *http.Request) {
+	species, err := s.api.Species(r.PathValue("name"))
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, speciesInfo{
+		Name:        species.Name,
+		CaptureRate: species.CaptureRate,
+		GenderRate:  species.GenderRate,
+		Legendary:   species.IsLegendary,
+		Mythical:    species.IsMythical,
+		GrowthRate:  species.GrowthRate,
+		Region:      species.Region,
+	})
+}
+
+// listAreas pages through areas with offset, e.g. /api/pokeapi/areas?offset=20
+func (s *Server) listAreas(w http.ResponseWriter, r *http.Request) {
+	offset := 0
+	if value := r.URL.Query().Get("offset"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, errors.New("offset must be zero or more"))
+			return
+		}
+		offset = n
+	}
+
+	areas, err := s.api.Areas(offset)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	if areas == nil {
+		areas = []string{}
+	}
+	writeJSON(w, http.StatusOK, areasResponse{Offset: offset, Areas: areas})
+}
+
+func (s *Server) lookupArea(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	encounters, err := s.api.Area(name)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, exploreResponse{Area: name, Encounters: encounterList(encounters), Messages: []string{}})
+}
+
+func encounterList(encounters []pokeapi.Encounter) []encounter {
+	list := make([]encounter, 0, len(encounters))
+	for _, e := range encounters {
+		list = append(list, encounter{Name: e.Name, MinLevel: e.MinLevel, MaxLevel: e.MaxLevel})
+	}
+	return list
+}
+
+func pathID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id < 1 {
+		writeError(w, http.StatusBadRequest, errors.New("id must be a positive number"))
+		return 0, false
+	}
+	return id, true
+}
+
+// statusFor picks the status code for an error from the game or PokeAPI.
+// Failures reaching PokeAPI are the upstream's fault, not ours, and data
+// missing offline can't be served until it is synced.
+func statusFor(err error) int {
+	var urlErr *url.Error
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, pokeapi.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrRejected):
+		return http.StatusConflict
+	case errors.Is(err, pokeapi.ErrUnavailable), errors.As(err, &urlErr):
+		return http.StatusBadGateway
+	case errors.Is(err, pokeapi.ErrNotInSnapshot):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		writeError(w, http.StatusUnsupportedMediaType, errors.New("request body must be application/json"))
+		return false
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid JSON body: "+err.Error()))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,332 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+)
+
+type fakeGame struct {
+	entries  []pokedex.Entry
+	released []int
+	catchErr error
+}
+
+func (g *fakeGame) Entries() []pokedex.Entry {
+	return g.entries
+}
+
+func (g *fakeGame) Release(id int) (pokedex.Entry, error) {
+	for i, entry := range g.entries {
+		if entry.ID == id {
+			if len(g.entries) == 1 {
+				return pokedex.Entry{}, Reject(errors.New("you can't leave your party empty"))
+			}
+			g.entries = append(g.entries[:i], g.entries[i+1:]...)
+			g.released = append(g.released, id)
+			return entry, nil
+		}
+	}
+	return pokedex.Entry{}, ErrNotFound
+}
+
+func (g *fakeGame) Explore(area string) ([]pokeapi.Encounter, []string, error) {
+	if area != "viridian-forest-area" {
+		return nil, nil, pokeapi.ErrNotFound
+	}
+	return []pokeapi.Encounter{{Name: "caterpie", MinLevel: 3, MaxLevel: 5}}, []string{"Exploring viridian-forest-area..."}, nil
+}
+
+func (g *fakeGame) Catch(pokemon, ball, nickname string) (*pokedex.Entry, []string, error) {
+	if g.catchErr != nil {
+		return nil, nil, g.catchErr
+	}
+	if pokemon == "mewtwo" {
+		return nil, []string{"mewtwo escaped!"}, nil
+	}
+	entry := pokedex.Entry{ID: len(g.entries) + 1, Pokemon: pokeapi.PokemonDetails{Name: pokemon}, Level: 5, Ball: ball, Nickname: nickname}
+	g.entries = append(g.entries, entry)
+	return &entry, []string{pokemon + " was caught!"}, nil
+}
+
+type fakePokeAPI struct{}
+
+func (fakePokeAPI) Pokemon(name string) (*pokeapi.PokemonDetails, error) {
+	if name != "pikachu" {
+		return nil, pokeapi.ErrNotFound
+	}
+	return &pokeapi.PokemonDetails{Name: "pikachu", Types: []string{"electric"}, Stats: map[string]int{"speed": 90}}, nil
+}
+
+func (fakePokeAPI) Species(name string) (*pokeapi.PokemonSpecies, error) {
+	return nil, pokeapi.ErrUnavailable
+}
+
+func (fakePokeAPI) Area(name string) ([]pokeapi.Encounter, error) {
+	if name == "unsynced" {
+		return nil, fmt.Errorf("location-area/unsynced: %w", pokeapi.ErrNotInSnapshot)
+	}
+	return []pokeapi.Encounter{{Name: "pidgey", MinLevel: 2, MaxLevel: 4}}, nil
+}
+
+func (fakePokeAPI) Areas(offset int) ([]string, error) {
+	if offset > 0 {
+		return nil, nil
+	}
+	return []string{"route-1", "route-2"}, nil
+}
+
+func newTestServer() (*fakeGame, *httptest.Server) {
+	game := &fakeGame{entries: []pokedex.Entry{
+		{ID: 1, Pokemon: pokeapi.PokemonDetails{Name: "pikachu", Types: []string{"electric"}}, Level: 7},
+		{ID: 2, Pokemon: pokeapi.PokemonDetails{Name: "charmander", Types: []string{"fire"}}, Level: 12},
+	}}
+	return game, httptest.NewServer(New(game, fakePokeAPI{}))
+}
+
+func do(t *testing.T, method, url, body string, v any) int {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if res.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("%s %s: expected a JSON response, got %q", method, url, res.Header.Get("Content-Type"))
+	}
+	if v != nil {
+		if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+			t.Fatalf("%s %s: expected JSON, got %v", method, url, err)
+		}
+	}
+	return res.StatusCode
+}
+
+func TestListPokedex(t *testing.T) {
+	_, srv := newTestServer()
+	defer srv.Close()
+
+	var all pokedexResponse
+	if status := do(t, "GET", srv.URL+"/api/pokedex", "", &all); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if all.Count != 2 || all.Pokemon[0].Name != "pikachu" {
+		t.Errorf("expected both pokemon, got %+v", all)
+	}
+
+	var fire pokedexResponse
+	do(t, "GET", srv.URL+"/api/pokedex?q=type:fire", "", &fire)
+	if fire.Count != 1 || fire.Pokemon[0].Name != "charmander" {
+		t.Errorf("expected only charmander, got %+v", fire)
+	}
+
+	if status := do(t, "GET", srv.URL+"/api/pokedex?q=level>>3", "", nil); status != http.StatusBadRequest {
+		t.Errorf("expected a bad query to be a 400, got %d", status)
+	}
+	if status := do(t, "PUT", srv.URL+"/api/pokedex", "", nil); status != http.StatusMethodNotAllowed {
+		t.Errorf("expected a wrong method to be a 405, got %d", status)
+	}
+}
+
+func TestGetPokemon(t *testing.T) {
+	_, srv := newTestServer()
+	defer srv.Close()
+
+	var pokemon struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Level int    `json:"level"`
+	}
+	if status := do(t, "GET", srv.URL+"/api/pokedex/2", "", &pokemon); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if pokemon.ID != 2 || pokemon.Name != "charmander" || pokemon.Level != 12 {
+		t.Errorf("unexpected pokemon %+v", pokemon)
+	}
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/api/pokedex/9", http.StatusNotFound},
+		{"/api/pokedex/pikachu", http.StatusBadRequest},
+		{"/api/nothing", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		var body map[string]string
+		if status := do(t, "GET", srv.URL+tt.path, "", &body); status != tt.status || body["error"] == "" {
+			t.Errorf("%s: expected %d with an error, got %d %v", tt.path, tt.status, status, body)
+		}
+	}
+}
+
+func TestReleasePokemon(t *testing.T) {
+	game, srv := newTestServer()
+	defer srv.Close()
+
+	if status := do(t, "DELETE", srv.URL+"/api/pokedex/1", "", nil); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if len(game.released) != 1 || game.released[0] != 1 {
+		t.Errorf("expected #1 to be released, got %v", game.released)
+	}
+
+	if status := do(t, "DELETE", srv.URL+"/api/pokedex/1", "", nil); status != http.StatusNotFound {
+		t.Errorf("expected releasing twice to be a 404, got %d", status)
+	}
+
+	var body map[string]string
+	if status := do(t, "DELETE", srv.URL+"/api/pokedex/2", "", &body); status != http.StatusConflict {
+		t.Errorf("expected a refused release to be a 409, got %d", status)
+	}
+	if body["error"] != "you can't leave your party empty" {
+		t.Errorf("expected the game's reason, got %q", body["error"])
+	}
+}
+
+func TestExplore(t *testing.T) {
+	_, srv := newTestServer()
+	defer srv.Close()
+
+	var explored exploreResponse
+	if status := do(t, "POST", srv.URL+"/api/explore", `{"area":"viridian-forest-area"}`, &explored); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if len(explored.Encounters) != 1 || explored.Encounters[0] != (encounter{Name: "caterpie", MinLevel: 3, MaxLevel: 5}) {
+		t.Errorf("unexpected encounters %+v", explored.Encounters)
+	}
+	if len(explored.Messages) != 1 {
+		t.Errorf("expected the game's messages, got %v", explored.Messages)
+	}
+
+	tests := []struct {
+		body   string
+		status int
+	}{
+		{`{"area":"nowhere"}`, http.StatusNotFound},
+		{`{}`, http.StatusBadRequest},
+		{`{"area":1}`, http.StatusBadRequest},
+		{`{"area":"x","extra":true}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		if status := do(t, "POST", srv.URL+"/api/explore", tt.body, nil); status != tt.status {
+			t.Errorf("%s: expected %d, got %d", tt.body, tt.status, status)
+		}
+	}
+}
+
+func TestCatch(t *testing.T) {
+	game, srv := newTestServer()
+	defer srv.Close()
+
+	var caught catchResponse
+	if status := do(t, "POST", srv.URL+"/api/catch", `{"pokemon":"pidgey","nickname":"Gale"}`, &caught); status != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", status)
+	}
+	if !caught.Caught || caught.Pokemon == nil || caught.Pokemon.ID != 3 || caught.Pokemon.Nickname != "Gale" {
+		t.Errorf("unexpected catch %+v", caught)
+	}
+	if game.entries[2].Ball != "poke-ball" {
+		t.Errorf("expected a poke-ball by default, got %s", game.entries[2].Ball)
+	}
+
+	var escaped catchResponse
+	if status := do(t, "POST", srv.URL+"/api/catch", `{"pokemon":"mewtwo"}`, &escaped); status != http.StatusOK {
+		t.Fatalf("expected 200 for an escape, got %d", status)
+	}
+	if escaped.Caught || escaped.Pokemon != nil || escaped.Messages[0] != "mewtwo escaped!" {
+		t.Errorf("unexpected escape %+v", escaped)
+	}
+
+	if status := do(t, "POST", srv.URL+"/api/catch", `{"pokemon":"pidgey","nickname":"a very long nickname"}`, nil); status != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid nickname, got %d", status)
+	}
+	if len(game.entries) != 3 {
+		t.Errorf("expected no catch with an invalid nickname, got %d pokemon", len(game.entries))
+	}
+
+	game.catchErr = Reject(errors.New("you don't have any poke-ball left"))
+	if status := do(t, "POST", srv.URL+"/api/catch", `{"pokemon":"pidgey"}`, nil); status != http.StatusConflict {
+		t.Errorf("expected 409 without balls, got %d", status)
+	}
+}
+
+func TestRejectsOtherContentTypes(t *testing.T) {
+	_, srv := newTestServer()
+	defer srv.Close()
+
+	res, err := http.Post(srv.URL+"/api/catch", "text/plain", strings.NewReader(`{"pokemon":"pidgey"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("expected 415 for a text body, got %d", res.StatusCode)
+	}
+
+	res, err = http.Post(srv.URL+"/api/catch", "application/json; charset=utf-8", strings.NewReader(`{"pokemon":"pidgey"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("expected a JSON body with a charset to be accepted, got %d", res.StatusCode)
+	}
+}
+
+func TestLookups(t *testing.T) {
+	_, srv := newTestServer()
+	defer srv.Close()
+
+	var pokemon pokemonInfo
+	if status := do(t, "GET", srv.URL+"/api/pokeapi/pokemon/pikachu", "", &pokemon); status != http.StatusOK {
+		t.Fatalf("expected 200, got %d", status)
+	}
+	if pokemon.Species != "pikachu" || pokemon.Stats["speed"] != 90 || pokemon.Types[0] != "electric" {
+		t.Errorf("unexpected pokemon %+v", pokemon)
+	}
+
+	if status := do(t, "GET", srv.URL+"/api/pokeapi/pokemon/missingno", "", nil); status != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown pokemon, got %d", status)
+	}
+	if status := do(t, "GET", srv.URL+"/api/pokeapi/species/pikachu", "", nil); status != http.StatusBadGateway {
+		t.Errorf("expected 502 when PokeAPI fails, got %d", status)
+	}
+
+	var areas areasResponse
+	if status := do(t, "GET", srv.URL+"/api/pokeapi/areas", "", &areas); status != http.StatusOK || len(areas.Areas) != 2 {
+		t.Errorf("expected the first page of areas, got %d %+v", status, areas)
+	}
+	do(t, "GET", srv.URL+"/api/pokeapi/areas?offset=20", "", &areas)
+	if areas.Offset != 20 || areas.Areas == nil || len(areas.Areas) != 0 {
+		t.Errorf("expected an empty page past the end, got %+v", areas)
+	}
+	if status := do(t, "GET", srv.URL+"/api/pokeapi/areas?offset=-1", "", nil); status != http.StatusBadRequest {
+		t.Errorf("expected a bad offset to be a 400, got %d", status)
+	}
+
+	if status := do(t, "GET", srv.URL+"/api/pokeapi/areas/unsynced", "", nil); status != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 for data missing offline, got %d", status)
+	}
+
+	var area exploreResponse
+	do(t, "GET", srv.URL+"/api/pokeapi/areas/route-1", "", &area)
+	if area.Area != "route-1" || area.Encounters[0].Name != "pidgey" {
+		t.Errorf("unexpected area %+v", area)
+	}
+}
@@ -0,0 +1,178 @@
+package snapshot
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const Version = 1
+
+// Snapshot is a local copy of PokeAPI responses, keyed by resource path
+// such as pokemon/pikachu or location-area/?offset=20&limit=20.
+type Snapshot struct {
+	mu        sync.Mutex
+	created   time.Time
+	kinds     []string
+	resources map[string]json.RawMessage
+}
+
+func New(created time.Time) *Snapshot {
+	return &Snapshot{created: created, resources: make(map[string]json.RawMessage)}
+}
+
+// file is the gzipped JSON a snapshot is stored as.
+type file struct {
+	Version   int                        `json:"version"`
+	Created   time.Time                  `json:"created"`
+	Kinds     []string                   `json:"kinds"`
+	Resources map[string]json.RawMessage `json:"resources"`
+}
+
+// Path is where the game keeps its snapshot inside dir.
+func Path(dir string) string {
+	return filepath.Join(dir, "pokeapi-snapshot.json.gz")
+}
+
+func (s *Snapshot) Created() time.Time {
+	return s.created
+}
+
+// Put stores the response for a resource. It is safe to call from several
+// downloads at once.
+func (s *Snapshot) Put(key string, data []byte) error {
+	if !json.Valid(data) {
+		return fmt.Errorf("%s is not valid JSON", key)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources[key] = json.RawMessage(data)
+	return nil
+}
+
+func (s *Snapshot) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.resources[key]
+	return data, ok
+}
+
+func (s *Snapshot) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.resources)
+}
+
+// MarkSynced records that every resource of kind was downloaded, so one
+// missing from the snapshot doesn't exist at all.
+func (s *Snapshot) MarkSynced(kind string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !slices.Contains(s.kinds, kind) {
+		s.kinds = append(s.kinds, kind)
+		slices.Sort(s.kinds)
+	}
+}
+
+func (s *Snapshot) Synced(kind string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Contains(s.kinds, kind)
+}
+
+func (s *Snapshot) SyncedKinds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.kinds)
+}
+
+// Counts counts resources by kind, the first part of their key. Listing
+// pages count toward their kind too.
+func (s *Snapshot) Counts() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kinds := make(map[string]int)
+	for key := range s.resources {
+		kind, _, _ := strings.Cut(key, "/")
+		kinds[kind]++
+	}
+	return kinds
+}
+
+// Keys lists every resource in the snapshot in order.
+func (s *Snapshot) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.resources))
+	for key := range s.resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func Load(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot: %w", err)
+	}
+	defer zr.Close()
+
+	var stored file
+	if err := json.NewDecoder(zr).Decode(&stored); err != nil {
+		return nil, fmt.Errorf("reading snapshot: %w", err)
+	}
+	if stored.Version != Version {
+		return nil, fmt.Errorf("snapshot is version %d, this game reads version %d; run data sync again", stored.Version, Version)
+	}
+
+	s := New(stored.Created)
+	s.kinds = stored.Kinds
+	if stored.Resources != nil {
+		s.resources = stored.Resources
+	}
+	return s, nil
+}
+
+// Write saves the snapshot through a temporary file, so a failed sync never
+// leaves a broken snapshot behind.
+func (s *Snapshot) Write(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	zw := gzip.NewWriter(f)
+	err = json.NewEncoder(zw).Encode(file{Version: Version, Created: s.created, Kinds: s.kinds, Resources: s.resources})
+	s.mu.Unlock()
+	if err == nil {
+		err = zw.Close()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,97 @@
+package snapshot
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWriteLoad(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	s := New(created)
+	s.Put("pokemon/pikachu", []byte(`{"name":"pikachu"}`))
+	s.Put("pokemon/bulbasaur", []byte(`{"name":"bulbasaur"}`))
+	s.Put("location-area/?offset=0&limit=20", []byte(`{"results":[]}`))
+	s.MarkSynced("pokemon")
+	s.MarkSynced("pokemon")
+
+	path := Path(t.TempDir())
+	if err := s.Write(path); err != nil {
+		t.Fatalf("expected no error writing, got %v", err)
+	}
+
+	// The file is compressed
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gzip.NewReader(f); err != nil {
+		t.Errorf("expected a gzip file, got %v", err)
+	}
+	f.Close()
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected no error loading, got %v", err)
+	}
+	if !loaded.Created().Equal(created) {
+		t.Errorf("expected created %v, got %v", created, loaded.Created())
+	}
+	if data, ok := loaded.Get("pokemon/pikachu"); !ok || string(data) != `{"name":"pikachu"}` {
+		t.Errorf("expected pikachu, got %q", data)
+	}
+	if _, ok := loaded.Get("pokemon/mew"); ok {
+		t.Errorf("expected mew to be missing")
+	}
+
+	expected := map[string]int{"pokemon": 2, "location-area": 1}
+	if counts := loaded.Counts(); !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v, got %v", expected, counts)
+	}
+	if kinds := loaded.SyncedKinds(); !reflect.DeepEqual(kinds, []string{"pokemon"}) {
+		t.Errorf("expected only pokemon synced, got %v", kinds)
+	}
+	if loaded.Synced("location-area") {
+		t.Errorf("expected location areas not to count as synced")
+	}
+	if keys := loaded.Keys(); len(keys) != 3 || keys[0] != "location-area/?offset=0&limit=20" {
+		t.Errorf("expected sorted keys, got %v", keys)
+	}
+}
+
+func TestPut_Invalid(t *testing.T) {
+	s := New(time.Now())
+	if err := s.Put("pokemon/missingno", []byte("<html>")); err == nil {
+		t.Error("expected an error for a response that isn't JSON")
+	}
+	if s.Len() != 0 {
+		t.Errorf("expected nothing stored, got %d", s.Len())
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := Load(filepath.Join(dir, "missing.json.gz")); !os.IsNotExist(err) {
+		t.Errorf("expected a not exist error, got %v", err)
+	}
+
+	plain := filepath.Join(dir, "plain.json")
+	os.WriteFile(plain, []byte(`{"version":1}`), 0o644)
+	if _, err := Load(plain); err == nil {
+		t.Error("expected an error for an uncompressed file")
+	}
+
+	future := filepath.Join(dir, "future.json.gz")
+	f, _ := os.Create(future)
+	zw := gzip.NewWriter(f)
+	zw.Write([]byte(`{"version":99,"resources":{}}`))
+	zw.Close()
+	f.Close()
+	if _, err := Load(future); err == nil {
+		t.Error("expected an error for an unknown version")
+	}
+}
@@ -0,0 +1,51 @@
+package sprite
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Renderer turns sprite URLs into terminal art. What it draws is kept on disk
+// under dir, so each sprite is downloaded and rendered only once per mode and
+// width.
+type Renderer struct {
+	dir   string
+	mode  Mode
+	width int
+	fetch func(url string) ([]byte, error)
+}
+
+func NewRenderer(dir string, mode Mode, width int, fetch func(url string) ([]byte, error)) *Renderer {
+	return &Renderer{dir: dir, mode: mode, width: width, fetch: fetch}
+}
+
+func (r *Renderer) Render(url string) (string, error) {
+	path := r.cachePath(url)
+	if art, err := os.ReadFile(path); err == nil {
+		return string(art), nil
+	}
+
+	data, err := r.fetch(url)
+	if err != nil {
+		return "", err
+	}
+	img, err := Decode(data)
+	if err != nil {
+		return "", fmt.Errorf("can't read sprite: %w", err)
+	}
+	art := Render(Scale(Trim(img), r.width), r.mode)
+
+	// The cache only saves work, so art that can't be kept is still shown
+	if err := os.MkdirAll(r.dir, 0o755); err == nil {
+		os.WriteFile(path, []byte(art), 0o644)
+	}
+	return art, nil
+}
+
+func (r *Renderer) cachePath(url string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d", url, r.mode, r.width)))
+	return filepath.Join(r.dir, hex.EncodeToString(sum[:12])+".txt")
+}
@@ -0,0 +1,128 @@
+package sprite
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+)
+
+type Mode string
+
+const (
+	// ModeTruecolor draws two pixels per character with the upper half block
+	// and 24-bit foreground and background colors.
+	ModeTruecolor Mode = "truecolor"
+	// ModeASCII shades two pixels per character with plain characters.
+	ModeASCII Mode = "ascii"
+)
+
+// DetectMode picks truecolor when color output is on and the terminal says
+// it supports truecolor through COLORTERM, and ASCII otherwise. Whether color
+// is on at all is up to the caller, such as render.DetectColor.
+func DetectMode(getenv func(string) string, color bool) Mode {
+	if !color {
+		return ModeASCII
+	}
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ModeTruecolor
+	}
+	return ModeASCII
+}
+
+func Render(img image.Image, mode Mode) string {
+	if mode == ModeTruecolor {
+		return renderHalfBlocks(img)
+	}
+	return renderASCII(img)
+}
+
+// renderHalfBlocks draws each pair of rows as one line, with the upper pixel
+// as the foreground of an upper half block and the lower one as its background. Transparent
+// pixels are left to the terminal's own background.
+func renderHalfBlocks(img image.Image) string {
+	b := img.Bounds()
+	var sb strings.Builder
+	for y := b.Min.Y; y < b.Max.Y; y += 2 {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			top := img.At(x, y)
+			bottom := color.Color(color.Transparent)
+			if y+1 < b.Max.Y {
+				bottom = img.At(x, y+1)
+			}
+
+			switch {
+			case visible(top) && visible(bottom):
+				sb.WriteString(fg(top) + bg(bottom) + upperHalf)
+			case visible(top):
+				sb.WriteString(fg(top) + upperHalf)
+			case visible(bottom):
+				sb.WriteString(fg(bottom) + lowerHalf)
+			default:
+				sb.WriteString(" ")
+			}
+			sb.WriteString("\x1b[0m")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func fg(c color.Color) string {
+	r, g, b := rgb(c)
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", r, g, b)
+}
+
+func bg(c color.Color) string {
+	r, g, b := rgb(c)
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm", r, g, b)
+}
+
+func rgb(c color.Color) (uint8, uint8, uint8) {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return n.R, n.G, n.B
+}
+
+const (
+	upperHalf = "\u2580"
+	lowerHalf = "\u2584"
+)
+
+// ramp runs from the lightest to the darkest shade, which suits the usual
+// dark terminal where a dense character reads as bright.
+const ramp = ".:-=+*#%@"
+
+// renderASCII shades each pair of rows as one line by the average brightness
+// of the visible pixels, since a character is about twice as tall as wide.
+func renderASCII(img image.Image) string {
+	b := img.Bounds()
+	var sb strings.Builder
+	for y := b.Min.Y; y < b.Max.Y; y += 2 {
+		line := ""
+		for x := b.Min.X; x < b.Max.X; x++ {
+			total, count := 0.0, 0
+			for dy := 0; dy < 2 && y+dy < b.Max.Y; dy++ {
+				if c := img.At(x, y+dy); visible(c) {
+					total += luminance(c)
+					count++
+				}
+			}
+			if count == 0 {
+				line += " "
+				continue
+			}
+			shade := int(math.Round(total / float64(count) * float64(len(ramp)-1)))
+			line += string(ramp[shade])
+		}
+		sb.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+	return sb.String()
+}
+
+// luminance is the perceived brightness of c from 0 to 1.
+func luminance(c color.Color) float64 {
+	r, g, b := rgb(c)
+	return (0.2126*float64(r) + 0.7152*float64(g) + 0.0722*float64(b)) / 255
+}
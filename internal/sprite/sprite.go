@@ -0,0 +1,62 @@
+package sprite
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// Decode reads a PNG sprite.
+func Decode(data []byte) (image.Image, error) {
+	return png.Decode(bytes.NewReader(data))
+}
+
+// Trim crops the fully transparent border that PokeAPI sprites are padded
+// with. An image with nothing visible is returned as is.
+func Trim(img image.Image) image.Image {
+	b := img.Bounds()
+	box := image.Rectangle{}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if visible(img.At(x, y)) {
+				box = box.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	if box.Empty() {
+		return img
+	}
+
+	out := image.NewNRGBA(image.Rect(0, 0, box.Dx(), box.Dy()))
+	for y := 0; y < box.Dy(); y++ {
+		for x := 0; x < box.Dx(); x++ {
+			out.Set(x, y, img.At(box.Min.X+x, box.Min.Y+y))
+		}
+	}
+	return out
+}
+
+// Scale resizes img to width pixels, keeping its aspect ratio, by picking the
+// nearest pixel. Sprites are pixel art, so blending would only blur them.
+// Images narrower than width are left alone.
+func Scale(img image.Image, width int) image.Image {
+	b := img.Bounds()
+	if width <= 0 || b.Dx() <= width {
+		return img
+	}
+
+	height := max(1, b.Dy()*width/b.Dx())
+	out := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			out.Set(x, y, img.At(b.Min.X+x*b.Dx()/width, b.Min.Y+y*b.Dy()/height))
+		}
+	}
+	return out
+}
+
+func visible(c color.Color) bool {
+	_, _, _, a := c.RGBA()
+	return a >= 0x8000
+}
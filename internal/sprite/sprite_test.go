@@ -0,0 +1,160 @@
+package sprite
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func fixture(t *testing.T) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "sprite.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestTrimAndScale(t *testing.T) {
+	img, err := Decode(fixture(t))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	trimmed := Trim(img)
+	if b := trimmed.Bounds(); b.Dx() != 8 || b.Dy() != 8 {
+		t.Errorf("expected the 8x8 body, got %v", b)
+	}
+
+	scaled := Scale(trimmed, 4)
+	if b := scaled.Bounds(); b.Dx() != 4 || b.Dy() != 4 {
+		t.Errorf("expected 4x4, got %v", b)
+	}
+
+	if b := Scale(trimmed, 20).Bounds(); b.Dx() != 8 {
+		t.Errorf("expected small images to stay as they are, got %v", b)
+	}
+
+	if _, err := Decode([]byte("not a png")); err == nil {
+		t.Error("expected an error decoding garbage")
+	}
+}
+
+func TestRenderASCII(t *testing.T) {
+	img, _ := Decode(fixture(t))
+
+	expected := "@@@@@@@@\n--------\n--------\n........\n"
+	if got := Render(Trim(img), ModeASCII); got != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, got)
+	}
+}
+
+func TestRenderTruecolor(t *testing.T) {
+	img, _ := Decode(fixture(t))
+	art := Render(Trim(img), ModeTruecolor)
+
+	lines := strings.Split(strings.TrimSuffix(art, "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected 4 lines for 8 rows, got %d", len(lines))
+	}
+
+	white := "\x1b[38;2;255;255;255m\x1b[48;2;255;255;255m" + upperHalf
+	if !strings.HasPrefix(lines[0], white) {
+		t.Errorf("expected white half blocks on top, got %q", lines[0])
+	}
+
+	black := "\x1b[38;2;0;0;0m\x1b[48;2;0;0;0m" + upperHalf
+	if !strings.HasPrefix(lines[3], black) {
+		t.Errorf("expected black half blocks at the bottom, got %q", lines[3])
+	}
+
+	// Transparent pixels are plain spaces
+	if untrimmed := Render(img, ModeTruecolor); !strings.HasPrefix(untrimmed, " \x1b[0m") {
+		t.Errorf("expected the border to be blank, got %q", untrimmed[:20])
+	}
+}
+
+func TestDetectMode(t *testing.T) {
+	tests := []struct {
+		env      map[string]string
+		color    bool
+		expected Mode
+	}{
+		{map[string]string{"COLORTERM": "truecolor"}, true, ModeTruecolor},
+		{map[string]string{"COLORTERM": "24bit"}, true, ModeTruecolor},
+		{map[string]string{"COLORTERM": "truecolor"}, false, ModeASCII},
+		{map[string]string{"TERM": "xterm-256color"}, true, ModeASCII},
+	}
+
+	for _, tt := range tests {
+		getenv := func(key string) string { return tt.env[key] }
+		if got := DetectMode(getenv, tt.color); got != tt.expected {
+			t.Errorf("%v (color %t): expected %s, got %s", tt.env, tt.color, tt.expected, got)
+		}
+	}
+}
+
+func TestRendererCachesOnDisk(t *testing.T) {
+	png := fixture(t)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/broken.png" {
+			w.Write([]byte("not a png"))
+			return
+		}
+		w.Write(png)
+	}))
+	defer server.Close()
+
+	fetch := func(url string) ([]byte, error) {
+		res, err := http.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		return io.ReadAll(res.Body)
+	}
+
+	dir := t.TempDir()
+	renderer := NewRenderer(dir, ModeASCII, 4, fetch)
+
+	first, err := renderer.Render(server.URL + "/25.png")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// A new renderer over the same directory reads the art back from disk
+	again, err := NewRenderer(dir, ModeASCII, 4, fetch).Render(server.URL + "/25.png")
+	if err != nil || again != first {
+		t.Errorf("expected the cached art, got %q, %v", again, err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 download, got %d", requests)
+	}
+
+	// Another mode is rendered and cached separately
+	if _, err := NewRenderer(dir, ModeTruecolor, 4, fetch).Render(server.URL + "/25.png"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	files, _ := os.ReadDir(dir)
+	if requests != 2 || len(files) != 2 {
+		t.Errorf("expected 2 downloads and 2 cached files, got %d and %d", requests, len(files))
+	}
+
+	if _, err := renderer.Render(server.URL + "/broken.png"); err == nil {
+		t.Error("expected an error for a file that isn't a PNG")
+	}
+
+	// A cache that can't be written to still gives the art
+	blocked := filepath.Join(t.TempDir(), "file")
+	os.WriteFile(blocked, nil, 0o644)
+	art, err := NewRenderer(filepath.Join(blocked, "sprites"), ModeASCII, 4, fetch).Render(server.URL + "/25.png")
+	if err != nil || art != first {
+		t.Errorf("expected the art without a cache, got %q, %v", art, err)
+	}
+}
@@ -0,0 +1,61 @@
+package stats
+
+type Nature struct {
+	Name      string
+	Increased string
+	Decreased string
+}
+
+var Natures = []Nature{
+	{"hardy", "", ""},
+	{"lonely", "attack", "defense"},
+	{"brave", "attack", "speed"},
+	{"adamant", "attack", "special-attack"},
+	{"naughty", "attack", "special-defense"},
+	{"bold", "defense", "attack"},
+	{"docile", "", ""},
+	{"relaxed", "defense", "speed"},
+	{"impish", "defense", "special-attack"},
+	{"lax", "defense", "special-defense"},
+	{"timid", "speed", "attack"},
+	{"hasty", "speed", "defense"},
+	{"serious", "", ""},
+	{"jolly", "speed", "special-attack"},
+	{"naive", "speed", "special-defense"},
+	{"modest", "special-attack", "attack"},
+	{"mild", "special-attack", "defense"},
+	{"quiet", "special-attack", "speed"},
+	{"bashful", "", ""},
+	{"rash", "special-attack", "special-defense"},
+	{"calm", "special-defense", "attack"},
+	{"gentle", "special-defense", "defense"},
+	{"sassy", "special-defense", "speed"},
+	{"careful", "special-defense", "special-attack"},
+	{"quirky", "", ""},
+}
+
+func GetNature(name string) (Nature, bool) {
+	for _, nature := range Natures {
+		if nature.Name == name {
+			return nature, true
+		}
+	}
+	return Nature{}, false
+}
+
+func NatureModifier(nature, stat string) float64 {
+	n, ok := GetNature(nature)
+	if !ok {
+		return 1.0
+	}
+
+	switch stat {
+	case n.Increased:
+		if n.Increased != n.Decreased {
+			return 1.1
+		}
+	case n.Decreased:
+		return 0.9
+	}
+	return 1.0
+}
@@ -0,0 +1,32 @@
+package stats
+
+var Names = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+const (
+	MaxIV = 31
+	MaxEV = 252
+	// MaxBase is the highest base stat any Pokemon has, Blissey's HP.
+	MaxBase = 255
+)
+
+func HP(base, iv, ev, level int) int {
+	return ((2*base+iv+ev/4)*level)/100 + level + 10
+}
+
+func Other(base, iv, ev, level int, modifier float64) int {
+	return int(float64(((2*base+iv+ev/4)*level)/100+5) * modifier)
+}
+
+// Calculate turns base stats into the actual stats of a Pokemon at level.
+// Missing IVs or EVs count as zero and an unknown nature is neutral.
+func Calculate(base, ivs, evs map[string]int, level int, nature string) map[string]int {
+	actual := make(map[string]int, len(Names))
+	for _, name := range Names {
+		if name == "hp" {
+			actual[name] = HP(base[name], ivs[name], evs[name], level)
+		} else {
+			actual[name] = Other(base[name], ivs[name], evs[name], level, NatureModifier(nature, name))
+		}
+	}
+	return actual
+}
@@ -0,0 +1,86 @@
+package stats
+
+import "testing"
+
+func TestHP(t *testing.T) {
+	// Pikachu, base 35 at level 50
+	if got := HP(35, 0, 0, 50); got != 95 {
+		t.Errorf("expected 95, got %d", got)
+	}
+
+	// Garchomp, base 108 at level 78 with 24 IVs and 74 EVs
+	if got := HP(108, 24, 74, 78); got != 289 {
+		t.Errorf("expected 289, got %d", got)
+	}
+}
+
+func TestOther(t *testing.T) {
+	// Pikachu, base speed 90 at level 50
+	if got := Other(90, 0, 0, 50, 1.0); got != 95 {
+		t.Errorf("expected 95, got %d", got)
+	}
+
+	// Garchomp's attack with an adamant nature
+	if got := Other(130, 12, 190, 78, 1.1); got != 278 {
+		t.Errorf("expected 278, got %d", got)
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	base := map[string]int{
+		"hp":              35,
+		"attack":          55,
+		"defense":         40,
+		"special-attack":  50,
+		"special-defense": 50,
+		"speed":           90,
+	}
+
+	actual := Calculate(base, nil, nil, 100, "")
+
+	expected := map[string]int{
+		"hp":              180,
+		"attack":          115,
+		"defense":         85,
+		"special-attack":  105,
+		"special-defense": 105,
+		"speed":           185,
+	}
+
+	for name, want := range expected {
+		if actual[name] != want {
+			t.Errorf("expected %s %d, got %d", name, want, actual[name])
+		}
+	}
+}
+
+func TestCalculate_Nature(t *testing.T) {
+	base := map[string]int{"attack": 100, "defense": 100, "speed": 100}
+
+	actual := Calculate(base, nil, nil, 100, "lonely")
+
+	if actual["attack"] != 225 {
+		t.Errorf("expected boosted attack 225, got %d", actual["attack"])
+	}
+	if actual["defense"] != 184 {
+		t.Errorf("expected lowered defense 184, got %d", actual["defense"])
+	}
+	if actual["speed"] != 205 {
+		t.Errorf("expected neutral speed 205, got %d", actual["speed"])
+	}
+}
+
+func TestNatureModifier(t *testing.T) {
+	if got := NatureModifier("hardy", "attack"); got != 1.0 {
+		t.Errorf("expected neutral nature, got %v", got)
+	}
+	if got := NatureModifier("timid", "speed"); got != 1.1 {
+		t.Errorf("expected 1.1, got %v", got)
+	}
+	if got := NatureModifier("timid", "attack"); got != 0.9 {
+		t.Errorf("expected 0.9, got %v", got)
+	}
+	if got := NatureModifier("unknown", "attack"); got != 1.0 {
+		t.Errorf("expected unknown nature to be neutral, got %v", got)
+	}
+}
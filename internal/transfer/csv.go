@@ -0,0 +1,201 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/save"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+// Lists inside a cell are joined with listSeparator, and moves are written as
+// name:level.
+const listSeparator = "|"
+
+var csvColumns = []string{
+	"id", "name", "species", "nickname", "level", "experience", "growth_rate",
+	"nature", "gender", "shiny", "types", "abilities", "moves", "ball", "area",
+	"region", "caught_at", "base_experience", "height", "weight", "tags",
+}
+
+// optionalColumns may be missing from files exported by older versions.
+var optionalColumns = []string{"tags"}
+
+// csvHeader lists the fixed columns followed by, for each stat, the final
+// value and its base, IV and EV. Final stats are for spreadsheets only and
+// are recalculated on import.
+func csvHeader() []string {
+	header := slices.Clone(csvColumns)
+	for _, name := range stats.Names {
+		header = append(header, name, "base_"+name, "iv_"+name, "ev_"+name)
+	}
+	return header
+}
+
+func writeCSV(w io.Writer, pokemon []save.Pokemon) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader()); err != nil {
+		return err
+	}
+
+	for _, p := range pokemon {
+		moves := make([]string, len(p.Moves))
+		for i, move := range p.Moves {
+			moves[i] = fmt.Sprintf("%s:%d", move.Name, move.Level)
+		}
+
+		row := []string{
+			strconv.Itoa(p.ID), p.Name, p.Species, p.Nickname,
+			strconv.Itoa(p.Level), strconv.Itoa(p.Experience), p.GrowthRate,
+			p.Nature, p.Gender, strconv.FormatBool(p.Shiny),
+			strings.Join(p.Types, listSeparator),
+			strings.Join(p.Abilities, listSeparator),
+			strings.Join(moves, listSeparator),
+			p.Ball, p.Area, p.Region, p.CaughtAt.Format(time.RFC3339),
+			strconv.Itoa(p.BaseExperience), strconv.Itoa(p.Height), strconv.Itoa(p.Weight),
+			strings.Join(p.Tags, listSeparator),
+		}
+
+		actual := p.Entry().Stats()
+		for _, name := range stats.Names {
+			row = append(row,
+				strconv.Itoa(actual[name]),
+				strconv.Itoa(p.BaseStats[name]),
+				strconv.Itoa(p.IVs[name]),
+				strconv.Itoa(p.EVs[name]),
+			)
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func readCSV(r io.Reader) ([]save.Pokemon, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("empty csv file")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range csvHeader() {
+		if _, ok := columns[name]; !ok && !slices.Contains(optionalColumns, name) {
+			return nil, fmt.Errorf("csv is missing the %s column", name)
+		}
+	}
+
+	var pokemon []save.Pokemon
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := parseRow(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		pokemon = append(pokemon, p)
+	}
+	return pokemon, nil
+}
+
+func parseRow(record []string, columns map[string]int) (save.Pokemon, error) {
+	var err error
+	get := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	number := func(name string) int {
+		if err != nil {
+			return 0
+		}
+		var n int
+		if n, err = strconv.Atoi(get(name)); err != nil {
+			err = fmt.Errorf("%s must be a number, got %q", name, get(name))
+		}
+		return n
+	}
+
+	p := save.Pokemon{
+		ID:             number("id"),
+		Name:           get("name"),
+		Species:        get("species"),
+		Nickname:       get("nickname"),
+		Level:          number("level"),
+		Experience:     number("experience"),
+		GrowthRate:     get("growth_rate"),
+		Nature:         get("nature"),
+		Gender:         get("gender"),
+		Types:          splitList(get("types")),
+		Abilities:      splitList(get("abilities")),
+		Tags:           splitList(get("tags")),
+		Moves:          []save.Move{},
+		Ball:           get("ball"),
+		Area:           get("area"),
+		Region:         get("region"),
+		BaseExperience: number("base_experience"),
+		Height:         number("height"),
+		Weight:         number("weight"),
+		BaseStats:      make(map[string]int, len(stats.Names)),
+		IVs:            make(map[string]int, len(stats.Names)),
+		EVs:            make(map[string]int, len(stats.Names)),
+	}
+	for _, name := range stats.Names {
+		p.BaseStats[name] = number("base_" + name)
+		p.IVs[name] = number("iv_" + name)
+		p.EVs[name] = number("ev_" + name)
+	}
+	if err != nil {
+		return save.Pokemon{}, err
+	}
+
+	if p.Shiny, err = strconv.ParseBool(get("shiny")); err != nil {
+		return save.Pokemon{}, fmt.Errorf("shiny must be true or false, got %q", get("shiny"))
+	}
+
+	if p.CaughtAt, err = time.Parse(time.RFC3339, get("caught_at")); err != nil {
+		return save.Pokemon{}, fmt.Errorf("caught_at must look like 2024-05-01T12:00:00Z, got %q", get("caught_at"))
+	}
+
+	for _, move := range splitList(get("moves")) {
+		name, level, ok := strings.Cut(move, ":")
+		n, convErr := strconv.Atoi(level)
+		if !ok || convErr != nil {
+			return save.Pokemon{}, fmt.Errorf("moves look like name:level, got %q", move)
+		}
+		p.Moves = append(p.Moves, save.Move{Name: name, Level: n})
+	}
+
+	return p, nil
+}
+
+func splitList(cell string) []string {
+	if cell == "" {
+		return nil
+	}
+	return strings.Split(cell, listSeparator)
+}
@@ -0,0 +1,74 @@
+package transfer
+
+import (
+	"maps"
+
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+)
+
+// Conflict is an imported Pokemon whose ID was already taken by a different
+// one. It is imported under NewID instead.
+type Conflict struct {
+	Incoming pokedex.Entry
+	Existing pokedex.Entry
+	NewID    int
+}
+
+type MergeReport struct {
+	Added     []pokedex.Entry
+	Skipped   []pokedex.Entry
+	Conflicts []Conflict
+}
+
+// Merge adds incoming entries to dex, keeping their IDs where possible.
+// Entries that are already in the collection are skipped.
+func Merge(dex *pokedex.Pokedex, incoming []pokedex.Entry) MergeReport {
+	var report MergeReport
+	for _, entry := range incoming {
+		existing, taken := dex.Get(entry.ID)
+		if !taken && dex.Restore(entry) == nil {
+			report.Added = append(report.Added, entry)
+			continue
+		}
+		if taken && sameCatch(existing, entry) {
+			report.Skipped = append(report.Skipped, entry)
+			continue
+		}
+
+		added := dex.Add(entry)
+		report.Added = append(report.Added, added)
+		report.Conflicts = append(report.Conflicts, Conflict{Incoming: entry, Existing: existing, NewID: added.ID})
+	}
+	return report
+}
+
+// Incoming counts the entries Merge would add to dex, leaving out the ones
+// it would skip as already there. It changes nothing, so callers can check
+// there is room first.
+func Incoming(dex *pokedex.Pokedex, incoming []pokedex.Entry) int {
+	pending := make(map[int]pokedex.Entry)
+	count := 0
+	for _, entry := range incoming {
+		existing, taken := dex.Get(entry.ID)
+		if !taken {
+			existing, taken = pending[entry.ID]
+		}
+		if taken && sameCatch(existing, entry) {
+			continue
+		}
+		if !taken {
+			pending[entry.ID] = entry
+		}
+		count++
+	}
+	return count
+}
+
+// sameCatch reports whether two entries are the same individual Pokemon,
+// such as when a collection is imported twice.
+func sameCatch(a, b pokedex.Entry) bool {
+	return a.Pokemon.Name == b.Pokemon.Name &&
+		a.CaughtAt.Equal(b.CaughtAt) &&
+		a.Nature == b.Nature &&
+		maps.Equal(a.IVs, b.IVs)
+}
@@ -0,0 +1,97 @@
+package transfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/save"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	}
+	return "", fmt.Errorf("unknown format %q, expected json or csv", s)
+}
+
+// FormatFromPath guesses the format from a file extension, defaulting to JSON.
+func FormatFromPath(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return FormatCSV
+	}
+	return FormatJSON
+}
+
+const documentVersion = 1
+
+// document is the JSON export. Pokemon use the same form as the save file.
+type document struct {
+	Version int            `json:"version"`
+	Pokemon []save.Pokemon `json:"pokemon"`
+}
+
+func Export(w io.Writer, format Format, entries []pokedex.Entry) error {
+	pokemon := make([]save.Pokemon, 0, len(entries))
+	for _, entry := range entries {
+		pokemon = append(pokemon, save.FromEntry(entry))
+	}
+
+	if format == FormatCSV {
+		return writeCSV(w, pokemon)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document{Version: documentVersion, Pokemon: pokemon})
+}
+
+// Import reads and validates an export. Nothing is returned unless every
+// Pokemon in it is valid.
+func Import(r io.Reader, format Format) ([]pokedex.Entry, error) {
+	var pokemon []save.Pokemon
+	if format == FormatCSV {
+		var err error
+		if pokemon, err = readCSV(r); err != nil {
+			return nil, err
+		}
+	} else {
+		var doc document
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("invalid export file: %w", err)
+		}
+		if doc.Version != documentVersion {
+			return nil, fmt.Errorf("unsupported export version %d", doc.Version)
+		}
+		pokemon = doc.Pokemon
+	}
+
+	ids := make(map[int]bool, len(pokemon))
+	entries := make([]pokedex.Entry, 0, len(pokemon))
+	for i, p := range pokemon {
+		if err := p.Validate(); err != nil {
+			return nil, fmt.Errorf("pokemon %d: %w", i+1, err)
+		}
+		if ids[p.ID] {
+			return nil, fmt.Errorf("pokemon %d: duplicate id %d", i+1, p.ID)
+		}
+		ids[p.ID] = true
+		entries = append(entries, p.Entry())
+	}
+	return entries, nil
+}
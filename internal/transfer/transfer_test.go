@@ -0,0 +1,198 @@
+package transfer
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/stats"
+)
+
+func testEntries() []pokedex.Entry {
+	stat := func(values ...int) map[string]int {
+		m := make(map[string]int, len(stats.Names))
+		for i, name := range stats.Names {
+			m[name] = values[i]
+		}
+		return m
+	}
+
+	return []pokedex.Entry{
+		{
+			ID: 1,
+			Pokemon: pokeapi.PokemonDetails{
+				Name:           "pikachu",
+				Species:        "pikachu",
+				BaseExperience: 112,
+				Height:         4,
+				Weight:         60,
+				Stats:          stat(35, 55, 40, 50, 50, 90),
+				Types:          []string{"electric"},
+				Moves:          []pokeapi.LearnedMove{{Name: "thunder-shock", Level: 1}, {Name: "quick-attack", Level: 6}},
+				Abilities:      []string{"static", "lightning-rod"},
+			},
+			Level:      12,
+			Experience: 1728,
+			GrowthRate: "medium",
+			IVs:        stat(31, 0, 12, 5, 8, 31),
+			EVs:        stat(0, 0, 0, 0, 0, 20),
+			Nature:     "timid",
+			Gender:     "female",
+			Shiny:      true,
+			Nickname:   "sparky, jr",
+			Tags:       []string{"favorite", "starter"},
+			CaughtAt:   time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+			Area:       "viridian-forest-area",
+			Region:     "kanto",
+			Ball:       "great-ball",
+		},
+		{
+			ID: 4,
+			Pokemon: pokeapi.PokemonDetails{
+				Name:  "charizard",
+				Stats: stat(78, 84, 78, 109, 85, 100),
+				Types: []string{"fire", "flying"},
+				Moves: []pokeapi.LearnedMove{},
+			},
+			Level:    36,
+			IVs:      stat(1, 2, 3, 4, 5, 6),
+			EVs:      stat(0, 0, 0, 0, 0, 0),
+			Nature:   "hardy",
+			Gender:   "male",
+			CaughtAt: time.Date(2024, 6, 2, 8, 0, 0, 0, time.UTC),
+			Ball:     "poke-ball",
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatJSON, FormatCSV} {
+		var buf bytes.Buffer
+		if err := Export(&buf, format, testEntries()); err != nil {
+			t.Fatalf("%s: expected no error exporting, got %v", format, err)
+		}
+
+		entries, err := Import(&buf, format)
+		if err != nil {
+			t.Fatalf("%s: expected no error importing, got %v", format, err)
+		}
+
+		if !reflect.DeepEqual(entries, testEntries()) {
+			t.Errorf("%s: expected entries to round trip\nexpected %+v\ngot      %+v", format, testEntries(), entries)
+		}
+	}
+}
+
+func TestExportCSV_Columns(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, FormatCSV, testEntries()[:1]); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected a header and one row, got %d lines", len(lines))
+	}
+
+	if !strings.HasPrefix(lines[0], "id,name,species,nickname,level") {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+
+	// Final stats are written next to base, IV and EV
+	if !strings.Contains(lines[0], "speed,base_speed,iv_speed,ev_speed") {
+		t.Errorf("expected per-stat columns, got %q", lines[0])
+	}
+
+	if !strings.Contains(lines[1], `"sparky, jr"`) || !strings.Contains(lines[1], "thunder-shock:1|quick-attack:6") {
+		t.Errorf("unexpected row %q", lines[1])
+	}
+}
+
+func TestImport_Invalid(t *testing.T) {
+	var valid bytes.Buffer
+	Export(&valid, FormatCSV, testEntries()[:1])
+	header, row, _ := strings.Cut(valid.String(), "\n")
+
+	tests := []struct {
+		name   string
+		format Format
+		input  string
+	}{
+		{"not json", FormatJSON, "nope"},
+		{"wrong version", FormatJSON, `{"version":2,"pokemon":[]}`},
+		{"unknown field", FormatJSON, `{"version":1,"pokemon":[],"trainer":"red"}`},
+		{"bad level", FormatJSON, `{"version":1,"pokemon":[{"id":1,"name":"pikachu","level":0}]}`},
+		{"empty csv", FormatCSV, ""},
+		{"missing column", FormatCSV, "id,name\n1,pikachu\n"},
+		{"bad number", FormatCSV, header + "\n" + strings.Replace(row, ",12,", ",twelve,", 1)},
+		{"bad nature", FormatCSV, header + "\n" + strings.Replace(row, "timid", "grumpy", 1)},
+		{"duplicate id", FormatCSV, header + "\n" + row + row},
+		{"bad tag", FormatCSV, header + "\n" + strings.Replace(row, "favorite|starter", "fave!", 1)},
+	}
+
+	for _, tt := range tests {
+		if _, err := Import(strings.NewReader(tt.input), tt.format); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestImportCSV_WithoutTags(t *testing.T) {
+	var buf bytes.Buffer
+	Export(&buf, FormatCSV, testEntries()[:1])
+	header, row, _ := strings.Cut(buf.String(), "\n")
+
+	// Exports from before tags had no tags column
+	header = strings.Replace(header, ",tags,", ",", 1)
+	row = strings.Replace(row, ",favorite|starter,", ",", 1)
+
+	entries, err := Import(strings.NewReader(header+"\n"+row), FormatCSV)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entries) != 1 || entries[0].Tags != nil {
+		t.Errorf("expected one untagged pokemon, got %+v", entries)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	dex := pokedex.NewPokedex()
+	dex.Add(testEntries()[0])
+	dex.Add(pokedex.Entry{Pokemon: pokeapi.PokemonDetails{Name: "zubat"}})
+	dex.Add(pokedex.Entry{Pokemon: pokeapi.PokemonDetails{Name: "geodude"}})
+	dex.Add(pokedex.Entry{Pokemon: pokeapi.PokemonDetails{Name: "onix"}})
+
+	if n := Incoming(dex, testEntries()); n != 1 {
+		t.Errorf("expected only charizard to need room, got %d", n)
+	}
+	if n := Incoming(pokedex.NewPokedex(), append(testEntries(), testEntries()...)); n != len(testEntries()) {
+		t.Errorf("expected an entry listed twice to count once, got %d", n)
+	}
+
+	report := Merge(dex, testEntries())
+
+	if len(report.Skipped) != 1 || report.Skipped[0].Pokemon.Name != "pikachu" {
+		t.Errorf("expected the identical pikachu to be skipped, got %v", report.Skipped)
+	}
+
+	if len(report.Conflicts) != 1 {
+		t.Fatalf("expected one conflict, got %d", len(report.Conflicts))
+	}
+
+	conflict := report.Conflicts[0]
+	if conflict.Existing.Pokemon.Name != "onix" || conflict.NewID != 5 {
+		t.Errorf("expected charizard to clash with onix and move to #5, got %+v", conflict)
+	}
+
+	if entry, _ := dex.Get(5); entry.Pokemon.Name != "charizard" {
+		t.Errorf("expected charizard at #5, got %s", entry.Pokemon.Name)
+	}
+
+	if len(report.Added) != 1 || report.Added[0].ID != 5 {
+		t.Errorf("expected charizard to be added as #5, got %v", report.Added)
+	}
+}
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"bufio"
+	"io"
+	"time"
+)
+
+type Code int
+
+const (
+	KeyRune Code = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyPageUp
+	KeyPageDown
+	KeyEnter
+	KeyTab
+	KeyBackspace
+	KeyEscape
+	KeyCtrlC
+)
+
+// Key is one key press. Rune is only set for KeyRune.
+type Key struct {
+	Code Code
+	Rune rune
+}
+
+// ReadKey reads one key press from a terminal in raw mode, turning the
+// escape sequences for arrow and paging keys into their codes.
+func ReadKey(r *bufio.Reader) (Key, error) {
+	c, _, err := r.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+
+	switch c {
+	case '\r', '\n':
+		return Key{Code: KeyEnter}, nil
+	case '\t':
+		return Key{Code: KeyTab}, nil
+	case 127, '\b':
+		return Key{Code: KeyBackspace}, nil
+	case 3:
+		return Key{Code: KeyCtrlC}, nil
+	case 27:
+		return readEscape(r)
+	}
+	return Key{Code: KeyRune, Rune: c}, nil
+}
+
+// readEscape tells a lone Escape from the start of a sequence such as
+// ESC [ A by whether more input is already waiting.
+func readEscape(r *bufio.Reader) (Key, error) {
+	if r.Buffered() == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if r.Buffered() == 0 {
+		return Key{Code: KeyEscape}, nil
+	}
+
+	if next, _ := r.Peek(1); next[0] != '[' && next[0] != 'O' {
+		return Key{Code: KeyEscape}, nil
+	}
+	r.ReadByte()
+
+	seq := ""
+	for {
+		b, err := r.ReadByte()
+		if err == io.EOF {
+			return Key{Code: KeyEscape}, nil
+		}
+		if err != nil {
+			return Key{}, err
+		}
+		seq += string(b)
+		if b >= '@' && b <= '~' {
+			break
+		}
+	}
+
+	switch seq {
+	case "A":
+		return Key{Code: KeyUp}, nil
+	case "B":
+		return Key{Code: KeyDown}, nil
+	case "C":
+		return Key{Code: KeyRight}, nil
+	case "D":
+		return Key{Code: KeyLeft}, nil
+	case "5~":
+		return Key{Code: KeyPageUp}, nil
+	case "6~":
+		return Key{Code: KeyPageDown}, nil
+	}
+	return Key{Code: KeyEscape}, nil
+}
@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	reverse = "\x1b[7m"
+	reset   = "\x1b[0m"
+)
+
+// Pane is a titled, bordered list. Selected is highlighted when the pane has
+// focus, and the list scrolls to keep it in view. A Selected of -1 means the
+// pane has nothing to select.
+type Pane struct {
+	Title    string
+	Lines    []string
+	Selected int
+	Focused  bool
+}
+
+// Render draws the pane as height rows of exactly width visible columns.
+func (p Pane) Render(width, height int) []string {
+	if width < 4 || height < 3 {
+		return blank(width, height)
+	}
+	inner := width - 2
+	rows := height - 2
+
+	title := p.Title
+	if p.Focused {
+		title = "[" + title + "]"
+	}
+	top := "+" + Fit("-"+title+strings.Repeat("-", inner), inner) + "+"
+	out := []string{top}
+
+	offset := 0
+	if p.Selected >= rows {
+		offset = p.Selected - rows + 1
+	}
+	for i := offset; i < offset+rows; i++ {
+		line := ""
+		if i < len(p.Lines) {
+			line = p.Lines[i]
+		}
+		cell := Fit(line, inner)
+		if i == p.Selected && p.Focused && i < len(p.Lines) {
+			cell = reverse + Fit(Strip(line), inner) + reset
+		}
+		out = append(out, "|"+cell+"|")
+	}
+	return append(out, "+"+strings.Repeat("-", inner)+"+")
+}
+
+func blank(width, height int) []string {
+	out := make([]string, max(height, 0))
+	for i := range out {
+		out[i] = strings.Repeat(" ", max(width, 0))
+	}
+	return out
+}
+
+// Columns lays panes out side by side with the given widths.
+func Columns(height int, panes []Pane, widths []int) []string {
+	rows := make([]string, height)
+	for i, pane := range panes {
+		for y, line := range pane.Render(widths[i], height) {
+			rows[y] += line
+		}
+	}
+	return rows
+}
+
+// Fit pads or cuts s to exactly width visible columns. ANSI escape sequences
+// take no room and are kept, and a cut line is reset so colors don't leak.
+func Fit(s string, width int) string {
+	var sb strings.Builder
+	visible := 0
+	escaped := false
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			end := escapeEnd(s, i)
+			sb.WriteString(s[i:end])
+			escaped = true
+			i = end
+			continue
+		}
+		if visible == width {
+			break
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '\t' {
+			r = ' '
+		}
+		sb.WriteRune(r)
+		visible++
+		i += size
+	}
+	if escaped {
+		sb.WriteString(reset)
+	}
+	sb.WriteString(strings.Repeat(" ", width-visible))
+	return sb.String()
+}
+
+// Strip removes ANSI escape sequences from s.
+func Strip(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			i = escapeEnd(s, i)
+			continue
+		}
+		sb.WriteByte(s[i])
+		i++
+	}
+	return sb.String()
+}
+
+// escapeEnd returns the index just past the CSI sequence starting at i.
+func escapeEnd(s string, i int) int {
+	j := i + 1
+	if j < len(s) && s[j] == '[' {
+		j++
+		for j < len(s) && (s[j] < '@' || s[j] > '~') {
+			j++
+		}
+	}
+	return min(j+1, len(s))
+}
@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// MakeRaw switches the terminal behind tty to raw mode, so keys arrive one at
+// a time without echo, and returns a function that restores the old mode.
+// It shells out to stty to stay within the standard library.
+func MakeRaw(tty *os.File) (func() error, error) {
+	saved, err := stty(tty, "-g")
+	if err != nil {
+		return nil, fmt.Errorf("not a terminal: %w", err)
+	}
+	if _, err := stty(tty, "raw", "-echo"); err != nil {
+		return nil, err
+	}
+	return func() error {
+		_, err := stty(tty, saved)
+		return err
+	}, nil
+}
+
+// Size returns the width and height of the terminal behind tty.
+func Size(tty *os.File) (int, int, error) {
+	out, err := stty(tty, "size")
+	if err != nil {
+		return 0, 0, err
+	}
+	var rows, cols int
+	if _, err := fmt.Sscan(out, &rows, &cols); err != nil {
+		return 0, 0, err
+	}
+	return cols, rows, nil
+}
+
+func stty(tty *os.File, args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = tty
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadKey(t *testing.T) {
+	input := "j\x1b[A\x1b[B\x1b[C\x1b[D\x1b[5~\x1b[6~\r\t\x7f\x03é\x1b"
+	r := bufio.NewReader(strings.NewReader(input))
+
+	expected := []Key{
+		{Code: KeyRune, Rune: 'j'},
+		{Code: KeyUp},
+		{Code: KeyDown},
+		{Code: KeyRight},
+		{Code: KeyLeft},
+		{Code: KeyPageUp},
+		{Code: KeyPageDown},
+		{Code: KeyEnter},
+		{Code: KeyTab},
+		{Code: KeyBackspace},
+		{Code: KeyCtrlC},
+		{Code: KeyRune, Rune: 'é'},
+		{Code: KeyEscape},
+	}
+
+	for i, want := range expected {
+		got, err := ReadKey(r)
+		if err != nil {
+			t.Fatalf("key %d: unexpected error %v", i, err)
+		}
+		if got != want {
+			t.Errorf("key %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+
+	if _, err := ReadKey(r); err == nil {
+		t.Error("expected an error at the end of input")
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		input    string
+		width    int
+		expected string
+	}{
+		{"pikachu", 10, "pikachu   "},
+		{"pikachu", 4, "pika"},
+		{"", 3, "   "},
+		{"\x1b[31mred\x1b[0m", 5, "\x1b[31mred\x1b[0m\x1b[0m  "},
+		{"\x1b[31mredder", 3, "\x1b[31mred\x1b[0m"},
+	}
+
+	for _, tt := range tests {
+		if got := Fit(tt.input, tt.width); got != tt.expected {
+			t.Errorf("Fit(%q, %d): expected %q, got %q", tt.input, tt.width, tt.expected, got)
+		}
+	}
+
+	if got := Strip("\x1b[38;2;1;2;3mab\x1b[0mc"); got != "abc" {
+		t.Errorf("expected escapes stripped, got %q", got)
+	}
+}
+
+func TestPaneRender(t *testing.T) {
+	pane := Pane{Title: "Areas", Lines: []string{"one", "two", "three", "four"}, Selected: 3, Focused: true}
+
+	got := pane.Render(10, 5)
+	expected := []string{
+		"+-[Areas]+",
+		"|two     |",
+		"|three   |",
+		"|" + reverse + "four    " + reset + "|",
+		"+--------+",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected\n%q\ngot\n%q", expected, got)
+	}
+
+	// Without focus there is no highlight and short lists are padded
+	unfocused := Pane{Title: "Dex", Lines: []string{"mew"}}.Render(8, 4)
+	if unfocused[1] != "|mew   |" || unfocused[2] != "|      |" {
+		t.Errorf("unexpected rows %q", unfocused)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	rows := Columns(3, []Pane{{Title: "A"}, {Title: "B"}}, []int{5, 6})
+	expected := []string{"+-A-++-B--+", "|   ||    |", "+---++----+"}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %q, got %q", expected, rows)
+	}
+}
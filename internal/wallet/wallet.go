@@ -0,0 +1,56 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+type Wallet struct {
+	mu      sync.Mutex
+	balance int
+}
+
+func NewWallet(balance int) *Wallet {
+	return &Wallet{
+		balance: balance,
+	}
+}
+
+func (w *Wallet) Balance() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.balance
+}
+
+func (w *Wallet) Deposit(amount int) error {
+	if amount < 0 {
+		return errors.New("amount must not be negative")
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.balance += amount
+	return nil
+}
+
+func (w *Wallet) Withdraw(amount int) error {
+	if amount < 0 {
+		return errors.New("amount must not be negative")
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.balance < amount {
+		return fmt.Errorf("not enough money: need $%d, have $%d", amount, w.balance)
+	}
+	w.balance -= amount
+	return nil
+}
+
+func (w *Wallet) Set(balance int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.balance = balance
+}
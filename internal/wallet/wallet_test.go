@@ -0,0 +1,43 @@
+package wallet
+
+import "testing"
+
+func TestDepositAndWithdraw(t *testing.T) {
+	w := NewWallet(100)
+
+	if err := w.Deposit(50); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := w.Withdraw(120); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := w.Balance(); got != 30 {
+		t.Errorf("expected balance 30, got %d", got)
+	}
+}
+
+func TestWithdrawInsufficientFunds(t *testing.T) {
+	w := NewWallet(10)
+
+	if err := w.Withdraw(11); err == nil {
+		t.Error("expected error when overdrawing")
+	}
+
+	if got := w.Balance(); got != 10 {
+		t.Errorf("expected balance to stay 10, got %d", got)
+	}
+}
+
+func TestNegativeAmounts(t *testing.T) {
+	w := NewWallet(10)
+
+	if err := w.Deposit(-1); err == nil {
+		t.Error("expected error for negative deposit")
+	}
+
+	if err := w.Withdraw(-1); err == nil {
+		t.Error("expected error for negative withdrawal")
+	}
+}
@@ -0,0 +1,246 @@
+"use strict";
+
+const MAX_BASE_STAT = 255;
+const PAGE_SIZE = 20;
+const STAT_LABELS = {
+  "hp": "HP",
+  "attack": "Atk",
+  "defense": "Def",
+  "special-attack": "SpA",
+  "special-defense": "SpD",
+  "speed": "Spe",
+};
+
+const state = {
+  types: new Set(),
+  offset: 0,
+  area: "",
+};
+
+const $ = (id) => document.getElementById(id);
+
+// api calls the JSON API and throws the server's error message on failure.
+async function api(method, path, body) {
+  const options = { method, headers: {} };
+  if (body !== undefined) {
+    options.headers["Content-Type"] = "application/json";
+    options.body = JSON.stringify(body);
+  }
+  const res = await fetch(path, options);
+  const data = await res.json();
+  if (!res.ok) {
+    throw new Error(data.error || res.statusText);
+  }
+  return data;
+}
+
+function el(tag, className, text) {
+  const node = document.createElement(tag);
+  if (className) node.className = className;
+  if (text !== undefined) node.textContent = text;
+  return node;
+}
+
+let toastTimer;
+function toast(message) {
+  const box = $("toast");
+  box.textContent = message;
+  box.classList.remove("hidden");
+  clearTimeout(toastTimer);
+  toastTimer = setTimeout(() => box.classList.add("hidden"), 4000);
+}
+
+function badge(type, tag) {
+  const node = el(tag || "span", "badge type-" + type, type);
+  return node;
+}
+
+// Pokedex
+
+async function loadPokedex() {
+  try {
+    const all = await api("GET", "/api/pokedex");
+    renderTypeFilters(all.pokemon);
+
+    const terms = $("search").value.trim().split(/\s+/).filter(Boolean);
+    if (state.types.size > 0) {
+      terms.push("type:" + [...state.types].join(","));
+    }
+    const shown = terms.length > 0 ? await api("GET", "/api/pokedex?q=" + encodeURIComponent(terms.join(" "))) : all;
+
+    $("pokedex-summary").textContent = `Showing ${shown.count} of ${all.count} pokemon`;
+    const cards = $("cards");
+    cards.replaceChildren(...shown.pokemon.map(card));
+  } catch (err) {
+    $("pokedex-summary").textContent = err.message;
+  }
+}
+
+function renderTypeFilters(pokemon) {
+  const types = new Set(pokemon.flatMap((p) => p.types));
+  for (const type of state.types) {
+    if (!types.has(type)) state.types.delete(type);
+  }
+
+  const buttons = [...types].sort().map((type) => {
+    const button = badge(type, "button");
+    button.classList.toggle("selected", state.types.has(type));
+    button.addEventListener("click", () => {
+      if (state.types.has(type)) {
+        state.types.delete(type);
+      } else {
+        state.types.add(type);
+      }
+      loadPokedex();
+    });
+    return button;
+  });
+  $("type-filters").replaceChildren(...buttons);
+}
+
+function card(pokemon) {
+  const node = el("article", "card");
+
+  const header = el("header");
+  const title = el("h3", "", pokemon.nickname || pokemon.name);
+  if (pokemon.shiny) title.textContent += " \u2728";
+  header.append(title, el("span", "id", "#" + pokemon.id));
+  node.append(header);
+
+  if (pokemon.nickname) {
+    node.append(el("div", "nickname", pokemon.name));
+  }
+
+  const sprite = pokemon.shiny && pokemon.shiny_sprite ? pokemon.shiny_sprite : pokemon.sprite;
+  if (sprite) {
+    const img = el("img");
+    img.src = sprite;
+    img.alt = pokemon.name;
+    node.append(img);
+  } else {
+    node.append(el("div", "sprite-missing", "?"));
+  }
+
+  const types = el("div");
+  types.append(...pokemon.types.map((type) => badge(type)));
+  node.append(types, el("div", "level", `Lv. ${pokemon.level} \u00b7 ${pokemon.nature}`));
+
+  const stats = el("table", "stats");
+  for (const [name, label] of Object.entries(STAT_LABELS)) {
+    const value = (pokemon.base_stats || {})[name] || 0;
+    const row = el("tr");
+    const bar = el("div", "bar");
+    const fill = el("span");
+    fill.style.width = Math.min(100, (value * 100) / MAX_BASE_STAT) + "%";
+    bar.append(fill);
+    const barCell = el("td");
+    barCell.append(bar);
+    row.append(el("td", "", label), el("td", "value", String(value)), barCell);
+    stats.append(row);
+  }
+  node.append(stats);
+  return node;
+}
+
+// Areas
+
+async function loadAreas() {
+  $("page-label").textContent = "Loading...";
+  try {
+    const page = await api("GET", "/api/pokeapi/areas?offset=" + state.offset);
+    $("page-label").textContent = `${state.offset + 1}-${state.offset + page.areas.length}`;
+    $("prev-page").disabled = state.offset === 0;
+    $("next-page").disabled = page.areas.length < PAGE_SIZE;
+
+    const items = page.areas.map((name) => {
+      const item = el("li", name === state.area ? "selected" : "", name);
+      item.addEventListener("click", () => explore(name));
+      return item;
+    });
+    $("area-names").replaceChildren(...items);
+  } catch (err) {
+    $("page-label").textContent = "";
+    toast(err.message);
+  }
+}
+
+async function explore(area) {
+  state.area = area;
+  for (const item of $("area-names").children) {
+    item.classList.toggle("selected", item.textContent === area);
+  }
+  $("area-title").textContent = area;
+
+  try {
+    const result = await api("POST", "/api/explore", { area });
+    log(result.messages);
+    const items = result.encounters.map((encounter) => {
+      const item = el("li");
+      const levels = encounter.min_level === encounter.max_level
+        ? `Lv. ${encounter.min_level}`
+        : `Lv. ${encounter.min_level}-${encounter.max_level}`;
+      const button = el("button", "", "Catch");
+      button.addEventListener("click", () => catchPokemon(encounter.name, button));
+      item.append(el("span", "", `${encounter.name} (${levels})`), button);
+      return item;
+    });
+    $("encounter-list").replaceChildren(...items);
+  } catch (err) {
+    $("encounter-list").replaceChildren();
+    toast(err.message);
+  }
+}
+
+async function catchPokemon(name, button) {
+  button.disabled = true;
+  try {
+    const result = await api("POST", "/api/catch", { pokemon: name, ball: $("ball").value });
+    log(result.messages);
+    toast(result.caught ? `Caught ${name}!` : `${name} escaped!`);
+    if (result.caught) loadPokedex();
+  } catch (err) {
+    toast(err.message);
+  } finally {
+    button.disabled = false;
+  }
+}
+
+function log(lines) {
+  $("log").textContent = lines.join("\n");
+}
+
+// Navigation
+
+function show(view) {
+  for (const tab of document.querySelectorAll(".tab")) {
+    tab.classList.toggle("active", tab.dataset.view === view);
+  }
+  for (const section of document.querySelectorAll(".view")) {
+    section.classList.toggle("hidden", section.id !== view);
+  }
+  if (view === "areas" && $("area-names").children.length === 0) {
+    loadAreas();
+  }
+}
+
+for (const tab of document.querySelectorAll(".tab")) {
+  tab.addEventListener("click", () => show(tab.dataset.view));
+}
+
+let searchTimer;
+$("search").addEventListener("input", () => {
+  clearTimeout(searchTimer);
+  searchTimer = setTimeout(loadPokedex, 250);
+});
+
+$("prev-page").addEventListener("click", () => {
+  state.offset = Math.max(0, state.offset - PAGE_SIZE);
+  loadAreas();
+});
+
+$("next-page").addEventListener("click", () => {
+  state.offset += PAGE_SIZE;
+  loadAreas();
+});
+
+loadPokedex();
@@ -0,0 +1,24 @@
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var static embed.FS
+
+// Handler serves the web interface, handing everything under /api/ to api.
+func Handler(api http.Handler) http.Handler {
+	files, err := fs.Sub(static, "static")
+	if err != nil {
+		// The directory is embedded above, so it is always there
+		panic(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", api)
+	mux.Handle("/", http.FileServerFS(files))
+	return mux
+}
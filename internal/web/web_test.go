@@ -0,0 +1,56 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "api "+r.URL.Path)
+	})
+	srv := httptest.NewServer(Handler(api))
+	defer srv.Close()
+
+	tests := []struct {
+		path        string
+		contentType string
+		contains    string
+	}{
+		{"/", "text/html", `<script src="app.js">`},
+		{"/app.js", "javascript", "/api/pokedex"},
+		{"/style.css", "text/css", ".card"},
+		{"/api/pokedex", "", "api /api/pokedex"},
+	}
+
+	for _, tt := range tests {
+		res, err := http.Get(srv.URL + tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("%s: expected 200, got %d", tt.path, res.StatusCode)
+		}
+		if !strings.Contains(res.Header.Get("Content-Type"), tt.contentType) {
+			t.Errorf("%s: expected %s, got %s", tt.path, tt.contentType, res.Header.Get("Content-Type"))
+		}
+		if !strings.Contains(string(body), tt.contains) {
+			t.Errorf("%s: expected the body to contain %q", tt.path, tt.contains)
+		}
+	}
+
+	res, err := http.Get(srv.URL + "/missing.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for a missing file, got %d", res.StatusCode)
+	}
+}
@@ -2,48 +2,140 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 )
 
+var input = bufio.NewScanner(os.Stdin)
+
 func main() {
+	profileName := flag.String("profile", "", "trainer profile to play as, created if it doesn't exist")
+	fullScreen := flag.Bool("tui", false, "browse areas and your pokedex in a full-screen terminal interface")
+	offline := flag.Bool("offline", false, "read PokeAPI data only from the snapshot downloaded with data sync")
+	flag.Parse()
+	setupOutput()
 
-	scanner := bufio.NewScanner(os.Stdin)
-	for {
-		fmt.Println("")
-		fmt.Print("Pokedex > ")
+	// Subcommands run something other than the REPL
+	subcommand := flag.Arg(0)
+	subFlags := flag.NewFlagSet(subcommand, flag.ExitOnError)
+	addr := subFlags.String("addr", "localhost:8080", "address to listen on, such as :8080 to accept other machines")
+	// The game's own flags work after the subcommand too
+	for _, name := range []string{"profile", "offline"} {
+		f := flag.Lookup(name)
+		subFlags.Var(f.Value, name, f.Usage)
+	}
+	switch subcommand {
+	case "", "serve", "web":
+	default:
+		fmt.Printf("Unknown command %s\n", subcommand)
+		os.Exit(2)
+	}
+	if subcommand != "" {
+		subFlags.Parse(flag.Args()[1:])
+	}
 
-		ok := scanner.Scan()
-		if !ok {
-			fmt.Println("Error in scan")
-			continue
+	if *offline {
+		if err := goOffline(); err != nil {
+			fmt.Printf("Error going offline: %s\n", err)
+			os.Exit(1)
 		}
+	}
 
-		text := scanner.Text()
-		text = strings.ToLower(text)
-		if text == "" {
-			fmt.Println("Please enter a command")
-			continue
+	if err := startGame(*profileName); err != nil {
+		fmt.Printf("Error loading save: %s\n", err)
+		os.Exit(1)
+	}
+
+	if subcommand == "serve" || subcommand == "web" {
+		if err := runServer(*addr, subcommand == "web"); err != nil {
+			fmt.Printf("Error serving: %s\n", err)
+			os.Exit(1)
 		}
+		return
+	}
 
-		fields := strings.Fields(text)
-		commandName := fields[0]
-		args := []string{}
-		if len(fields) > 1 {
-			args = fields[1:]
+	if *fullScreen {
+		if err := runTUI(); err != nil {
+			fmt.Printf("Error in TUI: %s\n", err)
+			os.Exit(1)
 		}
+		return
+	}
 
-		cmd, ok := supportedCommands[commandName]
+	for {
+		fmt.Println("")
+		fmt.Printf("Pokedex (%s) > ", activeProfile)
+
+		ok := input.Scan()
 		if !ok {
-			fmt.Println("Unknown command")
+			fmt.Println("Error in scan")
 			continue
 		}
 
-		err := cmd.callback(args)
-		if err != nil {
+		if err := runCommand(input.Text()); err != nil {
 			fmt.Printf("Error in function: %s", err)
-			continue
 		}
 	}
 }
+
+// runCommand runs one line of input and saves the game once it succeeds. The
+// REPL and the TUI both go through it, so they behave the same.
+func runCommand(line string) error {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		fmt.Println("Please enter a command")
+		return nil
+	}
+
+	commandName := strings.ToLower(fields[0])
+	cmd, ok := supportedCommands[commandName]
+	if !ok {
+		fmt.Println("Unknown command")
+		return nil
+	}
+
+	args := []string{}
+	for _, field := range fields[1:] {
+		if !cmd.keepCase {
+			field = strings.ToLower(field)
+		}
+		args = append(args, field)
+	}
+
+	if currentBattle != nil && !slices.Contains(battleCommands, commandName) {
+		fmt.Println("You can't do that during a battle")
+		return nil
+	}
+
+	// Measure the terminal once rather than for every table the command
+	// prints
+	if outputWidth == 0 {
+		outputWidth = terminalWidth()
+		defer func() { outputWidth = 0 }()
+	}
+
+	if err := cmd.callback(args); err != nil {
+		return err
+	}
+
+	if err := saveGame(); err != nil {
+		fmt.Printf("Error saving game: %s", err)
+	}
+	return nil
+}
+
+// confirm asks a yes or no question and reports whether the answer was yes.
+// The TUI swaps in its own prompt.
+var confirm = confirmLine
+
+func confirmLine(question string) bool {
+	fmt.Printf("%s (y/n) ", question)
+	if !input.Scan() {
+		return false
+	}
+	answer := strings.ToLower(strings.TrimSpace(input.Text()))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/Nachsus/pokedexcli/internal/render"
+	"github.com/Nachsus/pokedexcli/internal/tui"
+)
+
+var colorOutput bool
+
+// outputWidth is the width command output is laid out in: the terminal's,
+// measured once per command, or narrower somewhere like a TUI pane. Zero asks
+// the terminal.
+var outputWidth int
+
+func setupOutput() {
+	colorOutput = render.DetectColor(os.Getenv, isTerminal(os.Stdout))
+}
+
+// style is how command output should look right now.
+func style() render.Style {
+	width := outputWidth
+	if width == 0 {
+		width = terminalWidth()
+	}
+	return render.Style{Color: colorOutput, Width: width}
+}
+
+func terminalWidth() int {
+	if isTerminal(os.Stdout) {
+		if width, _, err := tui.Size(os.Stdin); err == nil && width > 0 {
+			return width
+		}
+	}
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+	return render.DefaultWidth
+}
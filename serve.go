@@ -0,0 +1,147 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/render"
+	"github.com/Nachsus/pokedexcli/internal/server"
+	"github.com/Nachsus/pokedexcli/internal/web"
+)
+
+// runServer serves the REST API, and the web interface on top of it when
+// withUI is set.
+func runServer(addr string, withUI bool) error {
+	// Actions answer with what the commands printed, which should read as
+	// plain text
+	colorOutput = false
+	outputWidth = render.DefaultWidth
+	sprites = nil
+
+	var handler http.Handler = server.New(gameAPI{}, pokeAPIClient{})
+	if withUI {
+		handler = web.Handler(handler)
+		fmt.Printf("Open http://%s in your browser to see the %s pokedex\n", browserAddr(addr), activeProfile)
+	} else {
+		fmt.Printf("Serving the %s pokedex on %s\n", activeProfile, addr)
+	}
+	return http.ListenAndServe(addr, handler)
+}
+
+// browserAddr fills in localhost for an address that only names a port.
+func browserAddr(addr string) string {
+	if strings.HasPrefix(addr, ":") {
+		return "localhost" + addr
+	}
+	return addr
+}
+
+// gameAPI lets the server drive the game through the same commands as the
+// REPL, so both follow the same rules and save the same way.
+type gameAPI struct{}
+
+func (gameAPI) Entries() []pokedex.Entry {
+	return userPokedex.GetAll()
+}
+
+func (g gameAPI) Release(id int) (pokedex.Entry, error) {
+	entry, ok := userPokedex.Get(id)
+	if !ok {
+		return pokedex.Entry{}, server.ErrNotFound
+	}
+	if _, err := g.run("release", strconv.Itoa(id), "--yes"); err != nil {
+		return pokedex.Entry{}, err
+	}
+	return entry, nil
+}
+
+func (g gameAPI) Explore(area string) ([]pokeapi.Encounter, []string, error) {
+	messages, err := g.run("explore", strings.ToLower(area))
+	if err != nil {
+		return nil, messages, err
+	}
+
+	encounters := make([]pokeapi.Encounter, 0, len(lastEncounters))
+	for _, encounter := range lastEncounters {
+		encounters = append(encounters, encounter)
+	}
+	slices.SortFunc(encounters, func(a, b pokeapi.Encounter) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return encounters, messages, nil
+}
+
+func (g gameAPI) Catch(pokemon, ball, nickname string) (*pokedex.Entry, []string, error) {
+	args := []string{strings.ToLower(pokemon), strings.ToLower(ball)}
+	if nickname != "" {
+		args = append(args, nickname)
+	}
+
+	caughtID := 0
+	stop := userPokedex.Subscribe(func(event pokedex.Event) {
+		if caught, ok := event.(pokedex.Caught); ok {
+			caughtID = caught.Entry.ID
+		}
+	})
+	messages, err := g.run("catch", args...)
+	stop()
+	if err != nil || caughtID == 0 {
+		return nil, messages, err
+	}
+
+	// Read it back, since it may have leveled up from the catch
+	entry, _ := userPokedex.Get(caughtID)
+	return &entry, messages, nil
+}
+
+// run runs a command and saves the game if it worked, returning the lines it
+// printed.
+func (gameAPI) run(name string, args ...string) ([]string, error) {
+	var err error
+	output := captureOutput(func() { err = supportedCommands[name].callback(args) })
+
+	messages := []string{}
+	if output != "" {
+		messages = strings.Split(strings.TrimRight(output, "\n"), "\n")
+	}
+	if err != nil {
+		return messages, apiError(err)
+	}
+	return messages, saveGame()
+}
+
+// apiError marks errors the game raised itself as rejected actions, and
+// leaves PokeAPI's errors to say what went wrong with the lookup.
+func apiError(err error) error {
+	var urlErr *url.Error
+	if errors.Is(err, pokeapi.ErrNotFound) || errors.Is(err, pokeapi.ErrUnavailable) ||
+		errors.Is(err, pokeapi.ErrNotInSnapshot) || errors.As(err, &urlErr) {
+		return err
+	}
+	return server.Reject(err)
+}
+
+type pokeAPIClient struct{}
+
+func (pokeAPIClient) Pokemon(name string) (*pokeapi.PokemonDetails, error) {
+	return pokeapi.GetPokemon(strings.ToLower(name), &pokeapi.Conf)
+}
+
+func (pokeAPIClient) Species(name string) (*pokeapi.PokemonSpecies, error) {
+	return pokeapi.GetPokemonSpecies(strings.ToLower(name), &pokeapi.Conf)
+}
+
+func (pokeAPIClient) Areas(offset int) ([]string, error) {
+	return pokeapi.MapsAt(offset, &pokeapi.Conf)
+}
+
+func (pokeAPIClient) Area(name string) ([]pokeapi.Encounter, error) {
+	return pokeapi.GetAreaEncounters(strings.ToLower(name), &pokeapi.Conf)
+}
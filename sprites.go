@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/sprite"
+)
+
+const spriteWidth = 32
+
+var sprites *sprite.Renderer
+
+// setupSprites renders into dir/sprites in the best style stdout supports.
+// It follows the color decision setupOutput already made.
+func setupSprites(dir string) {
+	mode := sprite.DetectMode(os.Getenv, colorOutput)
+	sprites = sprite.NewRenderer(filepath.Join(dir, "sprites"), mode, spriteWidth, pokeapi.GetSprite)
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// showSprite prints the Pokemon's art. Art is a nicety, so a Pokemon without
+// a sprite or one that can't be downloaded simply shows none.
+func showSprite(entry pokedex.Entry) {
+	if sprites == nil {
+		return
+	}
+
+	pokemon := entry.Pokemon
+	if pokemon.Sprite == "" {
+		// Saves from before sprites don't know the URL, so it is looked up
+		// once and kept with the Pokemon
+		details, err := pokeapi.GetPokemon(pokemon.Name, &pokeapi.Conf)
+		if err != nil {
+			return
+		}
+		pokemon.Sprite = details.Sprite
+		pokemon.ShinySprite = details.ShinySprite
+		if entry.ID != 0 {
+			updated := entry
+			updated.Pokemon = pokemon
+			userPokedex.Update(updated)
+		}
+	}
+
+	url := pokemon.Sprite
+	if entry.Shiny && pokemon.ShinySprite != "" {
+		url = pokemon.ShinySprite
+	}
+	art, err := sprites.Render(url)
+	if err != nil {
+		return
+	}
+	fmt.Print(art)
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/Nachsus/pokedexcli/internal/achievements"
+	"github.com/Nachsus/pokedexcli/internal/capture"
+	"github.com/Nachsus/pokedexcli/internal/inventory"
+	"github.com/Nachsus/pokedexcli/internal/pokeapi"
+	"github.com/Nachsus/pokedexcli/internal/pokedex"
+	"github.com/Nachsus/pokedexcli/internal/profile"
+	"github.com/Nachsus/pokedexcli/internal/quests"
+	"github.com/Nachsus/pokedexcli/internal/save"
+	"github.com/Nachsus/pokedexcli/internal/wallet"
+)
+
+var savePath string
+var activeProfile string
+var profiles *profile.Manager
+
+// startGame opens the profile manager and loads name, or the last active
+// profile when name is empty.
+func startGame(name string) error {
+	dir, err := save.DefaultDir()
+	if err != nil {
+		return err
+	}
+	profiles = profile.NewManager(dir)
+	setupSprites(dir)
+
+	legacy, err := save.DefaultPath()
+	if err != nil {
+		return err
+	}
+	if err := profiles.AdoptLegacy(legacy); err != nil {
+		return err
+	}
+
+	if name == "" {
+		if name, err = profiles.Active(); err != nil {
+			return err
+		}
+	}
+	if !profiles.Exists(name) {
+		if err := profiles.Create(name); err != nil {
+			return err
+		}
+		fmt.Printf("Created trainer profile %s\n", name)
+	}
+	if err := loadGame(name); err != nil {
+		return err
+	}
+
+	// Old saves were upgraded while loading, so anything left is damage
+	problems, err := save.Verify(savePath)
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		fmt.Printf("Warning: %s\n", problem)
+	}
+	return nil
+}
+
+// resetGame forgets everything about the current trainer before another
+// profile is loaded.
+func resetGame() {
+	userPokedex = pokedex.NewPokedex()
+	watchPokedex(userPokedex)
+	userStorage = pokedex.NewStorage()
+	userJournal = pokedex.NewJournal(journalSize)
+	userAchievements = achievements.NewTracker()
+	userQuests = quests.NewBoard()
+	userSeen = pokedex.NewSeen()
+	userInventory = inventory.NewInventory()
+	userWallet = wallet.NewWallet(0)
+	catchMode = capture.ModeAuthentic
+	lastArea = ""
+	lastAreas = nil
+	lastEncounters = make(map[string]pokeapi.Encounter)
+	currentBattle = nil
+	rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// gameState holds everything resetGame clears, so a failed profile switch
+// can put the previous trainer back.
+type gameState struct {
+	pokedex        *pokedex.Pokedex
+	storage        *pokedex.Storage
+	journal        *pokedex.Journal
+	achievements   *achievements.Tracker
+	quests         *quests.Board
+	seen           *pokedex.Seen
+	inventory      *inventory.Inventory
+	wallet         *wallet.Wallet
+	catchMode      capture.Mode
+	lastArea       string
+	lastAreas      []string
+	lastEncounters map[string]pokeapi.Encounter
+	battle         *wildEncounter
+	rng            *rand.Rand
+}
+
+func currentGame() gameState {
+	return gameState{
+		pokedex:        userPokedex,
+		storage:        userStorage,
+		journal:        userJournal,
+		achievements:   userAchievements,
+		quests:         userQuests,
+		seen:           userSeen,
+		inventory:      userInventory,
+		wallet:         userWallet,
+		catchMode:      catchMode,
+		lastArea:       lastArea,
+		lastAreas:      lastAreas,
+		lastEncounters: lastEncounters,
+		battle:         currentBattle,
+		rng:            rng,
+	}
+}
+
+func (g gameState) restore() {
+	userPokedex = g.pokedex
+	userStorage = g.storage
+	userJournal = g.journal
+	userAchievements = g.achievements
+	userQuests = g.quests
+	userSeen = g.seen
+	userInventory = g.inventory
+	userWallet = g.wallet
+	catchMode = g.catchMode
+	lastArea = g.lastArea
+	lastAreas = g.lastAreas
+	lastEncounters = g.lastEncounters
+	currentBattle = g.battle
+	rng = g.rng
+}
+
+// loadGame reads a profile into the freshly reset game. The save path and
+// active profile only change once everything loaded, so a save that fails
+// halfway never gets the half-loaded state written over it.
+func loadGame(name string) error {
+	path := profiles.SavePath(name)
+	state, err := save.Load(path)
+	if err != nil {
+		return err
+	}
+
+	if state.Settings.CatchMode != "" {
+		if catchMode, err = capture.ParseMode(state.Settings.CatchMode); err != nil {
+			return err
+		}
+	}
+	userWallet.Set(state.Money)
+	for name, quantity := range state.Inventory {
+		userInventory.Add(name, quantity)
+	}
+
+	for _, saved := range state.Pokemon {
+		if err := userPokedex.Restore(saved.Entry()); err != nil {
+			return err
+		}
+	}
+	for _, saved := range state.Seen {
+		userSeen.Restore(pokedex.Sighting{Name: saved.Name, Area: saved.Area, FirstSeen: saved.FirstSeen})
+	}
+	if err := userStorage.Restore(state.Party, state.Boxes); err != nil {
+		return err
+	}
+	if state.Achievements != nil {
+		restoreAchievements(state.Achievements)
+	}
+	if state.Quests != nil {
+		restoreQuests(state.Quests)
+	}
+	if err := drawQuests(time.Now()); err != nil {
+		fmt.Printf("Warning: %s (run quests to try again)\n", err)
+	}
+
+	// Keep storage and the pokedex in step even if the save was edited by
+	// hand: drop IDs nobody owns and place anyone left without a slot.
+	for _, id := range storedIDs() {
+		if _, ok := userPokedex.Get(id); !ok {
+			userStorage.Remove(id)
+		}
+	}
+	for _, entry := range userPokedex.GetAll() {
+		if _, ok := userStorage.Locate(entry.ID); !ok {
+			if _, err := userStorage.Place(entry.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := profiles.SetActive(name); err != nil {
+		return err
+	}
+	savePath = path
+	activeProfile = name
+	return nil
+}
+
+func saveGame() error {
+	entries := userPokedex.GetAll()
+	pokemon := make([]save.Pokemon, 0, len(entries))
+	for _, entry := range entries {
+		pokemon = append(pokemon, save.FromEntry(entry))
+	}
+
+	sightings := userSeen.GetAll()
+	seen := make([]save.Sighting, 0, len(sightings))
+	for _, sighting := range sightings {
+		seen = append(seen, save.Sighting{Name: sighting.Name, Area: sighting.Area, FirstSeen: sighting.FirstSeen})
+	}
+
+	state := &save.State{
+		Money:     userWallet.Balance(),
+		Inventory: userInventory.GetAll(),
+		Pokemon:   pokemon,
+		Party:     userStorage.Party(),
+		Boxes:     userStorage.Boxes(),
+		Seen:      seen,
+		Settings: save.Settings{
+			CatchMode: string(catchMode),
+		},
+		Achievements: savedAchievements(),
+		Quests:       savedQuests(),
+	}
+	return save.Write(savePath, state)
+}
+
+func restoreAchievements(saved *save.Achievements) {
+	progress := achievements.Progress{
+		Catches:   saved.Catches,
+		Escapes:   saved.Escapes,
+		Legendary: saved.Legendary,
+		Types:     saved.Types,
+		Species:   make(map[string]bool, len(saved.Species)),
+		Areas:     make(map[string]bool, len(saved.Areas)),
+	}
+	for _, name := range saved.Species {
+		progress.Species[name] = true
+	}
+	for _, area := range saved.Areas {
+		progress.Areas[area] = true
+	}
+	userAchievements.Restore(progress, saved.Unlocked, time.Now())
+}
+
+func savedAchievements() *save.Achievements {
+	progress := userAchievements.Progress()
+	return &save.Achievements{
+		Unlocked:  userAchievements.Unlocked(),
+		Catches:   progress.Catches,
+		Escapes:   progress.Escapes,
+		Legendary: progress.Legendary,
+		Types:     progress.Types,
+		Species:   slices.Sorted(maps.Keys(progress.Species)),
+		Areas:     slices.Sorted(maps.Keys(progress.Areas)),
+	}
+}
+
+func storedIDs() []int {
+	ids := userStorage.Party()
+	for _, box := range userStorage.Boxes() {
+		ids = append(ids, box...)
+	}
+	return ids
+}
+
+func restoreQuests(saved *save.Quests) {
+	board := make([]quests.Quest, 0, len(saved.Quests))
+	for _, q := range saved.Quests {
+		board = append(board, quests.Quest{Kind: quests.Kind(q.Kind), Type: q.Type, MinWeight: q.MinWeight, Goal: q.Goal, Count: q.Count})
+	}
+	userQuests.Restore(saved.Day, board, saved.Areas)
+}
+
+func savedQuests() *save.Quests {
+	day, board, areas := userQuests.State()
+	saved := &save.Quests{Day: day, Quests: make([]save.Quest, 0, len(board)), Areas: areas}
+	for _, q := range board {
+		saved.Quests = append(saved.Quests, save.Quest{Kind: string(q.Kind), Type: q.Type, MinWeight: q.MinWeight, Goal: q.Goal, Count: q.Count})
+	}
+	return saved
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Nachsus/pokedexcli/internal/tui"
+)
+
+const (
+	paneLocations = iota
+	paneEncounters
+	panePokedex
+	paneCount
+)
+
+const tuiHelp = "Tab: pane  j/k: move  Enter: open  c: catch  n/p: page areas  PgUp/PgDn: scroll  :: command  q: quit"
+
+// tuiApp is the full-screen interface. Everything it does goes through
+// runCommand with the output captured into the detail pane, so it behaves
+// exactly like typing the same commands into the REPL.
+type tuiApp struct {
+	tty      *os.File
+	keys     *bufio.Reader
+	focus    int
+	selected [paneCount]int
+
+	locations    []string
+	detailTitle  string
+	detail       []string
+	detailScroll int
+
+	status string
+	prompt []rune
+	typing bool
+	quit   bool
+}
+
+func runTUI() error {
+	restore, err := tui.MakeRaw(os.Stdin)
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	app := &tuiApp{
+		tty:         os.Stdout,
+		keys:        bufio.NewReader(os.Stdin),
+		detailTitle: "Welcome",
+		detail:      []string{"Pick an area and press Enter to explore it."},
+	}
+	confirm = app.confirm
+	defer func() { confirm = confirmLine }()
+
+	// Draw on the alternate screen with the cursor hidden
+	fmt.Fprint(app.tty, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(app.tty, "\x1b[?25h\x1b[?1049l")
+
+	app.pageLocations("map")
+	for !app.quit {
+		app.draw()
+		key, err := tui.ReadKey(app.keys)
+		if err != nil {
+			return err
+		}
+		app.handle(key)
+	}
+	return nil
+}
+
+// run runs a command line, showing what it printed in the detail pane and
+// any error in the status bar. Output is laid out to fit inside the pane.
+func (a *tuiApp) run(line string) {
+	width, _ := a.size()
+	outputWidth = width - 2
+	defer func() { outputWidth = 0 }()
+
+	var err error
+	out := captureOutput(func() { err = runCommand(line) })
+
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	a.detailTitle = line
+	a.detail = lines
+	a.detailScroll = 0
+	a.status = ""
+	if err != nil {
+		a.status = "Error: " + err.Error()
+	}
+}
+
+// captureOutput collects everything fn prints to stdout.
+func captureOutput(fn func()) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		fn()
+		return ""
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	done := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- string(data)
+	}()
+
+	fn()
+	w.Close()
+	os.Stdout = stdout
+	return <-done
+}
+
+func (a *tuiApp) pageLocations(command string) {
+	a.run(command)
+	if a.status != "" {
+		return
+	}
+	a.locations = lastAreas
+	a.selected[paneLocations] = 0
+	a.detailTitle, a.detail = "Areas", []string{fmt.Sprintf("%d areas on this page. Press Enter to explore one.", len(lastAreas))}
+}
+
+func (a *tuiApp) encounters() []string {
+	names := make([]string, 0, len(lastEncounters))
+	for name := range lastEncounters {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (a *tuiApp) paneLines(pane int) []string {
+	switch pane {
+	case paneLocations:
+		return a.locations
+	case paneEncounters:
+		return a.encounters()
+	}
+
+	var lines []string
+	for _, entry := range userPokedex.GetAll() {
+		lines = append(lines, describeEntry(entry))
+	}
+	return lines
+}
+
+// current returns what is selected in the focused pane: an area, a species
+// or a pokedex ID.
+func (a *tuiApp) current() (string, bool) {
+	if a.focus == panePokedex {
+		all := userPokedex.GetAll()
+		i := a.selected[panePokedex]
+		if i >= len(all) {
+			return "", false
+		}
+		return fmt.Sprint(all[i].ID), true
+	}
+
+	lines := a.paneLines(a.focus)
+	i := a.selected[a.focus]
+	if i >= len(lines) {
+		return "", false
+	}
+	return lines[i], true
+}
+
+func (a *tuiApp) handle(key tui.Key) {
+	if a.typing {
+		a.handlePrompt(key)
+		return
+	}
+
+	switch {
+	case key.Code == tui.KeyCtrlC || key.Rune == 'q':
+		a.quit = true
+	case key.Code == tui.KeyTab || key.Code == tui.KeyRight || key.Rune == 'l':
+		a.focus = (a.focus + 1) % paneCount
+	case key.Code == tui.KeyLeft || key.Rune == 'h':
+		a.focus = (a.focus + paneCount - 1) % paneCount
+	case key.Code == tui.KeyDown || key.Rune == 'j':
+		if a.selected[a.focus] < len(a.paneLines(a.focus))-1 {
+			a.selected[a.focus]++
+		}
+	case key.Code == tui.KeyUp || key.Rune == 'k':
+		if a.selected[a.focus] > 0 {
+			a.selected[a.focus]--
+		}
+	case key.Code == tui.KeyPageDown:
+		a.detailScroll = min(a.detailScroll+5, max(len(a.detail)-1, 0))
+	case key.Code == tui.KeyPageUp:
+		a.detailScroll = max(a.detailScroll-5, 0)
+	case key.Rune == 'n':
+		a.pageLocations("map")
+	case key.Rune == 'p':
+		a.pageLocations("mapb")
+	case key.Rune == ':':
+		a.typing, a.prompt = true, nil
+	case key.Rune == 'c' && a.focus == paneEncounters:
+		if name, ok := a.current(); ok {
+			a.run("catch " + name)
+		}
+	case key.Code == tui.KeyEnter:
+		a.open()
+	}
+}
+
+func (a *tuiApp) open() {
+	target, ok := a.current()
+	if !ok {
+		return
+	}
+
+	switch a.focus {
+	case paneLocations:
+		a.run("explore " + target)
+		if a.status == "" {
+			a.focus = paneEncounters
+			a.selected[paneEncounters] = 0
+		}
+	case paneEncounters, panePokedex:
+		a.run("inspect " + target)
+	}
+}
+
+func (a *tuiApp) handlePrompt(key tui.Key) {
+	switch key.Code {
+	case tui.KeyEscape, tui.KeyCtrlC:
+		a.typing = false
+	case tui.KeyBackspace:
+		if len(a.prompt) > 0 {
+			a.prompt = a.prompt[:len(a.prompt)-1]
+		}
+	case tui.KeyEnter:
+		a.typing = false
+		line := strings.TrimSpace(string(a.prompt))
+		// exit would end the process with the terminal still in raw mode
+		if fields := strings.Fields(line); len(fields) > 0 && fields[0] == "exit" {
+			a.quit = true
+			return
+		}
+		a.run(line)
+		a.clampSelections()
+	case tui.KeyRune:
+		a.prompt = append(a.prompt, key.Rune)
+	}
+}
+
+// clampSelections keeps every selection inside its list after a command
+// changed what the lists hold.
+func (a *tuiApp) clampSelections() {
+	for pane := range a.selected {
+		a.selected[pane] = max(min(a.selected[pane], len(a.paneLines(pane))-1), 0)
+	}
+}
+
+// confirm asks a yes or no question in the status bar.
+func (a *tuiApp) confirm(question string) bool {
+	a.status = question + " (y/n)"
+	a.draw()
+	key, err := tui.ReadKey(a.keys)
+	a.status = ""
+	return err == nil && (key.Rune == 'y' || key.Rune == 'Y')
+}
+
+func (a *tuiApp) size() (int, int) {
+	width, height, err := tui.Size(os.Stdin)
+	if err != nil || width == 0 || height == 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+func (a *tuiApp) draw() {
+	width, height := a.size()
+	a.clampSelections()
+
+	listHeight := max((height-2)/2, 5)
+	detailHeight := max(height-2-listHeight, 3)
+	third := width / 3
+
+	titles := [paneCount]string{"Areas", "Encounters in " + lastArea, fmt.Sprintf("Pokedex (%d)", len(userPokedex.GetAll()))}
+	if lastArea == "" {
+		titles[paneEncounters] = "Encounters"
+	}
+	var panes []tui.Pane
+	for pane := 0; pane < paneCount; pane++ {
+		panes = append(panes, tui.Pane{
+			Title:    titles[pane],
+			Lines:    a.paneLines(pane),
+			Selected: a.selected[pane],
+			Focused:  a.focus == pane,
+		})
+	}
+
+	rows := tui.Columns(listHeight, panes, []int{third, third, width - 2*third})
+	detail := a.detail[min(a.detailScroll, len(a.detail)):]
+	rows = append(rows, tui.Pane{Title: a.detailTitle, Lines: detail, Selected: -1}.Render(width, detailHeight)...)
+	rows = append(rows, "\x1b[7m"+tui.Fit(a.statusLine(), width)+"\x1b[0m")
+	if a.typing {
+		rows = append(rows, tui.Fit(":"+string(a.prompt), width))
+	} else {
+		rows = append(rows, tui.Fit(tuiHelp, width))
+	}
+
+	fmt.Fprint(a.tty, "\x1b[H"+strings.Join(rows, "\r\n"))
+}
+
+func (a *tuiApp) statusLine() string {
+	if a.status != "" {
+		return " " + a.status
+	}
+	line := fmt.Sprintf(" %s | $%d | %d Poke Balls | %d seen", activeProfile, userWallet.Balance(), userInventory.Count("poke-ball"), userSeen.Count())
+	if currentBattle != nil {
+		line += " | In battle: use :fight, :switch, :catch or :run"
+	}
+	return line
+}